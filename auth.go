@@ -1,16 +1,40 @@
 package systemd
 
 import (
+	"bufio"
 	"bytes"
+	"crypto/rand"
+	"crypto/sha1"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 )
 
+// Auth mechanisms which the Client supports, see WithAuth.
+const (
+	// AuthExternal authenticates with the credentials of the connection,
+	// i.e., the peer's uid of a Unix domain socket.
+	AuthExternal = "EXTERNAL"
+	// AuthCookieSHA1 authenticates with a secret cookie
+	// from the user's keyring in ~/.dbus-keyrings directory,
+	// so the Client and the server must share the home directory.
+	AuthCookieSHA1 = "DBUS_COOKIE_SHA1"
+	// AuthAnonymous authenticates without revealing the Client's identity.
+	// The message bus usually doesn't allow it.
+	AuthAnonymous = "ANONYMOUS"
+)
+
+// maxAuthLineLen limits the length of a line of the auth protocol,
+// so the server can't make the Client read indefinitely.
+const maxAuthLineLen = 16384
+
 /*
-authExternal performs EXTERNAL authentication,
+authenticate performs the authentication trying the mechanisms in order,
 see https://dbus.freedesktop.org/doc/dbus-specification.html#auth-protocol.
 The protocol is a line-based, where each line ends with \r\n.
 
@@ -20,8 +44,31 @@ The protocol is a line-based, where each line ends with \r\n.
 
 The client is authenticating as Unix uid 1000 in this example,
 where 31303030 is ASCII decimal 1000 represented in hex.
+Usually it's the effective uid of the process.
+
+The server replies with REJECTED followed by the mechanisms it supports
+if it doesn't accept the mechanism.
+In that case the next mechanism is tried unless the server doesn't support it.
+
+	client: AUTH EXTERNAL 31303030
+	server: REJECTED DBUS_COOKIE_SHA1 ANONYMOUS
+	client: AUTH ANONYMOUS
+	server: OK bde8d2222a9e966420ee8c1a63e972b4
+	client: BEGIN
+
+Once all the mechanisms were rejected,
+the *AuthError of each attempt is returned joined with errors.Join.
+
+When negotiateUnixFD is set, the client asks the server
+to enable Unix file descriptor passing before starting the session.
+
+	client: NEGOTIATE_UNIX_FD
+	server: AGREE_UNIX_FD
+
+The server might never reply, so the caller should set a deadline on the connection,
+in which case the "auth timed out" error is returned.
 */
-func authExternal(rw io.ReadWriter) error {
+func authenticate(rw io.ReadWriter, mechs []string, uid int, negotiateUnixFD bool) error {
 	var buf bytes.Buffer
 	buf.WriteByte(0)
 	// Send null byte as required by the protocol.
@@ -30,35 +77,282 @@ func authExternal(rw io.ReadWriter) error {
 		return fmt.Errorf("send null failed: %w", err)
 	}
 
-	uid := strconv.Itoa(os.Geteuid())
+	var (
+		errs            []error
+		isAuthenticated bool
+		// offered are the mechanisms the server listed when it rejected the previous one.
+		offered []string
+	)
+	for _, mech := range mechs {
+		if offered != nil && !hasString(offered, mech) {
+			continue
+		}
+
+		authErr, err := authMechanism(rw, &buf, mech, uid)
+		if err != nil {
+			return err
+		}
+		if authErr == nil {
+			isAuthenticated = true
+			break
+		}
+		errs = append(errs, authErr)
+
+		// The server's reply other than REJECTED, e.g., ERROR,
+		// means the Client can't continue the exchange.
+		rest, ok := strings.CutPrefix(authErr.ServerReply, "REJECTED")
+		if ok {
+			offered = strings.Fields(rest)
+		} else if authErr.ServerReply != "" {
+			break
+		}
+	}
+	if !isAuthenticated {
+		if len(errs) == 0 {
+			return fmt.Errorf("no auth mechanisms")
+		}
+		return errors.Join(errs...)
+	}
+
+	if negotiateUnixFD {
+		if err = negotiateUnixFDs(rw, &buf); err != nil {
+			return err
+		}
+	}
+
 	buf.Reset()
-	buf.WriteString("AUTH EXTERNAL ")
-	buf.WriteString(hex.EncodeToString([]byte(uid)))
-	buf.WriteString("\r\n")
+	buf.WriteString("BEGIN\r\n")
 	if _, err = rw.Write(buf.Bytes()); err != nil {
-		return fmt.Errorf("AUTH EXTERNAL uid: %w", err)
+		return fmt.Errorf("BEGIN: %w", err)
 	}
 
-	// Read 37 bytes such as
-	// "OK bde8d2222a9e966420ee8c1a63e972b4\r\n".
+	return nil
+}
+
+// authMechanism authenticates with the mechanism.
+// It returns *AuthError if the server didn't accept it,
+// or an error if the exchange failed, e.g., the server didn't reply.
+func authMechanism(rw io.ReadWriter, buf *bytes.Buffer, mech string, uid int) (*AuthError, error) {
 	buf.Reset()
-	buf.Grow(37)
-	b := buf.Bytes()[:buf.Cap()]
-	if _, err = rw.Read(b); err != nil {
-		return err
+	switch mech {
+	case AuthExternal, AuthCookieSHA1:
+		buf.WriteString("AUTH ")
+		buf.WriteString(mech)
+		buf.WriteByte(' ')
+		buf.WriteString(hex.EncodeToString([]byte(strconv.Itoa(uid))))
+	case AuthAnonymous:
+		buf.WriteString("AUTH ANONYMOUS")
+	default:
+		return &AuthError{
+			Mechanism: mech,
+			Err:       fmt.Errorf("unsupported mechanism"),
+		}, nil
+	}
+	buf.WriteString("\r\n")
+	if _, err := rw.Write(buf.Bytes()); err != nil {
+		return nil, fmt.Errorf("AUTH %s: %w", mech, err)
+	}
+
+	reply, err := readAuthLine(rw, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if mech == AuthCookieSHA1 && strings.HasPrefix(reply, "DATA ") {
+		if reply, err = authCookieSHA1(rw, buf, reply); err != nil {
+			var authErr *AuthError
+			if errors.As(err, &authErr) {
+				return authErr, nil
+			}
+			return nil, err
+		}
+	}
+
+	if strings.HasPrefix(reply, "OK") {
+		return nil, nil
 	}
 
+	return &AuthError{
+		Mechanism:   mech,
+		ServerReply: reply,
+	}, nil
+}
+
+/*
+authCookieSHA1 replies to the server's challenge of DBUS_COOKIE_SHA1 mechanism
+and returns the server's final reply.
+
+	client: AUTH DBUS_COOKIE_SHA1 31303030
+	server: DATA <hex of "org_freedesktop_general 1234 <server challenge>">
+	client: DATA <hex of "<client challenge> <sha1 hex>">
+	server: OK bde8d2222a9e966420ee8c1a63e972b4
+
+The cookie 1234 is looked up in ~/.dbus-keyrings/org_freedesktop_general file,
+and the SHA-1 is computed from "<server challenge>:<client challenge>:<cookie>".
+If the cookie can't be found, the Client cancels the exchange,
+and *AuthError is returned.
+*/
+func authCookieSHA1(rw io.ReadWriter, buf *bytes.Buffer, data string) (string, error) {
+	response, cookieErr := cookieSHA1Response(strings.TrimPrefix(data, "DATA "))
 	buf.Reset()
-	buf.WriteString("OK")
-	if !bytes.HasPrefix(b, []byte("OK")) {
-		return fmt.Errorf("expected OK, got %s", b)
+	if cookieErr != nil {
+		buf.WriteString("CANCEL\r\n")
+	} else {
+		buf.WriteString("DATA ")
+		buf.WriteString(hex.EncodeToString([]byte(response)))
+		buf.WriteString("\r\n")
+	}
+	if _, err := rw.Write(buf.Bytes()); err != nil {
+		return "", fmt.Errorf("DATA: %w", err)
 	}
 
+	reply, err := readAuthLine(rw, buf)
+	if err != nil {
+		return "", err
+	}
+	if cookieErr != nil {
+		return "", &AuthError{
+			Mechanism:   AuthCookieSHA1,
+			ServerReply: reply,
+			Err:         cookieErr,
+		}
+	}
+
+	return reply, nil
+}
+
+// cookieSHA1Response returns the response to the hex-encoded server's challenge
+// of DBUS_COOKIE_SHA1 mechanism, i.e., "<client challenge> <sha1 hex>".
+func cookieSHA1Response(data string) (string, error) {
+	challenge, err := hex.DecodeString(data)
+	if err != nil {
+		return "", fmt.Errorf("decode server challenge: %w", err)
+	}
+	fields := strings.Fields(string(challenge))
+	if len(fields) != 3 {
+		return "", fmt.Errorf("malformed server challenge: %q", challenge)
+	}
+	keyring, cookieID, serverChallenge := fields[0], fields[1], fields[2]
+	// The keyring name mustn't lead outside of the keyrings directory.
+	if strings.ContainsAny(keyring, `/\`) || strings.HasPrefix(keyring, ".") {
+		return "", fmt.Errorf("invalid keyring name: %q", keyring)
+	}
+
+	cookie, err := lookupCookie(keyring, cookieID)
+	if err != nil {
+		return "", err
+	}
+
+	b := make([]byte, 16)
+	if _, err = rand.Read(b); err != nil {
+		return "", fmt.Errorf("client challenge: %w", err)
+	}
+	clientChallenge := hex.EncodeToString(b)
+
+	sum := sha1.Sum([]byte(serverChallenge + ":" + clientChallenge + ":" + cookie))
+	return clientChallenge + " " + hex.EncodeToString(sum[:]), nil
+}
+
+// lookupCookie finds the cookie by its ID in the user's keyring.
+// Each line of the keyring file contains the cookie ID,
+// the time when the cookie was created, and the cookie itself.
+func lookupCookie(keyring, cookieID string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	f, err := os.Open(filepath.Join(home, ".dbus-keyrings", keyring))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 3 && fields[0] == cookieID {
+			return fields[2], nil
+		}
+	}
+	if err = s.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("cookie %s not found in keyring %s", cookieID, keyring)
+}
+
+// readAuthLine reads a line of the auth protocol,
+// and returns it without the trailing \r\n.
+// The line is read in small pieces, so the bytes which follow the line
+// aren't consumed, e.g., the server's reply to NEGOTIATE_UNIX_FD sent along with OK.
+// Since the OK line has a fixed length, it takes only two reads.
+func readAuthLine(r io.Reader, buf *bytes.Buffer) (string, error) {
+	// The shortest line is "ERROR\r\n",
+	// and the OK line is "OK bde8d2222a9e966420ee8c1a63e972b4\r\n".
+	const (
+		minLineLen = 7
+		okLineLen  = 37
+	)
+	var (
+		chunk [okLineLen]byte
+		okPfx = []byte("OK ")
+	)
 	buf.Reset()
-	buf.WriteString("BEGIN\r\n")
-	if _, err = rw.Write(buf.Bytes()); err != nil {
-		return fmt.Errorf("BEGIN: %w", err)
+	for n := minLineLen; ; n = 1 {
+		if bytes.HasPrefix(buf.Bytes(), okPfx) && buf.Len() < okLineLen {
+			n = okLineLen - buf.Len()
+		}
+
+		m, err := r.Read(chunk[:n])
+		buf.Write(chunk[:m])
+		if bytes.HasSuffix(buf.Bytes(), []byte("\r\n")) {
+			return string(buf.Bytes()[:buf.Len()-2]), nil
+		}
+		if err != nil {
+			return "", authReadError(err)
+		}
+		if buf.Len() > maxAuthLineLen {
+			return "", fmt.Errorf("auth line exceeded the maximum length: %d bytes", maxAuthLineLen)
+		}
+	}
+}
+
+// hasString reports whether ss contains s.
+func hasString(ss []string, s string) bool {
+	for i := range ss {
+		if ss[i] == s {
+			return true
+		}
+	}
+	return false
+}
+
+// negotiateUnixFDs asks the server to enable Unix file descriptor passing.
+// The server replies with AGREE_UNIX_FD if it supports it,
+// otherwise it replies with ERROR.
+func negotiateUnixFDs(rw io.ReadWriter, buf *bytes.Buffer) error {
+	buf.Reset()
+	buf.WriteString("NEGOTIATE_UNIX_FD\r\n")
+	if _, err := rw.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("NEGOTIATE_UNIX_FD: %w", err)
+	}
+
+	reply, err := readAuthLine(rw, buf)
+	if err != nil {
+		return err
+	}
+
+	if reply != "AGREE_UNIX_FD" {
+		return fmt.Errorf("expected AGREE_UNIX_FD, got %s", reply)
 	}
 
 	return nil
 }
+
+// authReadError clarifies the error that occurred while waiting for the server's reply.
+func authReadError(err error) error {
+	if errors.Is(err, os.ErrDeadlineExceeded) {
+		return fmt.Errorf("auth timed out: %w", err)
+	}
+	return err
+}
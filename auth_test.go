@@ -3,11 +3,17 @@ package systemd
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha1"
 	"encoding/hex"
+	"errors"
 	"io"
+	"net"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -21,7 +27,7 @@ func TestAuthExternal(t *testing.T) {
 		w,
 	)
 
-	if err := authExternal(rw); err != nil {
+	if err := authenticate(rw, []string{AuthExternal}, os.Geteuid(), false); err != nil {
 		t.Fatal(err)
 	}
 	w.Flush()
@@ -41,6 +47,26 @@ func TestAuthExternal(t *testing.T) {
 	}
 }
 
+func TestAuthExternalTimeout(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+	// The server reads the auth request, but never replies.
+	go io.Copy(io.Discard, server)
+
+	if err := client.SetDeadline(time.Now().Add(50 * time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+
+	err := authenticate(client, []string{AuthExternal}, os.Geteuid(), false)
+	if !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Fatalf("expected os.ErrDeadlineExceeded got %v", err)
+	}
+	if !strings.HasPrefix(err.Error(), "auth timed out") {
+		t.Errorf("expected auth timed out error got %q", err)
+	}
+}
+
 func BenchmarkAuthExternal(b *testing.B) {
 	authResp := bytes.NewReader([]byte("OK eb50e12940d90495b897de9f64090a3e\r\n"))
 	r := bufio.NewReader(authResp)
@@ -53,8 +79,270 @@ func BenchmarkAuthExternal(b *testing.B) {
 		authResp.Seek(0, io.SeekStart)
 		got.Reset()
 
-		if err := authExternal(rw); err != nil {
+		if err := authenticate(rw, []string{AuthExternal}, os.Geteuid(), false); err != nil {
 			b.Fatal(err)
 		}
 	}
 }
+
+func TestAuthExternalUnixFD(t *testing.T) {
+	const authResp = "OK eb50e12940d90495b897de9f64090a3e\r\nAGREE_UNIX_FD\r\n"
+	got := bytes.Buffer{}
+	w := bufio.NewWriter(&got)
+	rw := bufio.NewReadWriter(
+		bufio.NewReader(bytes.NewBufferString(authResp)),
+		w,
+	)
+	if err := authenticate(rw, []string{AuthExternal}, os.Geteuid(), true); err != nil {
+		t.Fatal(err)
+	}
+	w.Flush()
+
+	var want bytes.Buffer
+	{
+		uid := strconv.Itoa(os.Geteuid())
+		want.WriteByte(0)
+		want.WriteString("AUTH EXTERNAL ")
+		want.WriteString(hex.EncodeToString([]byte(uid)))
+		want.WriteString("\r\n")
+		want.WriteString("NEGOTIATE_UNIX_FD\r\n")
+		want.WriteString("BEGIN\r\n")
+	}
+	if diff := cmp.Diff(want.String(), got.String()); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestAuthExternalUnixFDError(t *testing.T) {
+	const authResp = "OK eb50e12940d90495b897de9f64090a3e\r\nERROR\r\n"
+	rw := bufio.NewReadWriter(
+		bufio.NewReader(bytes.NewBufferString(authResp)),
+		bufio.NewWriter(io.Discard),
+	)
+	err := authenticate(rw, []string{AuthExternal}, os.Geteuid(), true)
+	errMsg := "expected AGREE_UNIX_FD, got ERROR"
+	if err == nil || errMsg != err.Error() {
+		t.Fatalf("expected error %q got %q", errMsg, err)
+	}
+}
+
+func TestAuthExternalRejected(t *testing.T) {
+	const authResp = "REJECTED EXTERNAL\r\n"
+	rw := bufio.NewReadWriter(
+		bufio.NewReader(bytes.NewBufferString(authResp)),
+		bufio.NewWriter(io.Discard),
+	)
+	err := authenticate(rw, []string{AuthExternal}, os.Geteuid(), false)
+
+	var authErr *AuthError
+	if !errors.As(err, &authErr) {
+		t.Fatalf("expected AuthError got %v", err)
+	}
+	want := AuthError{
+		Mechanism:   "EXTERNAL",
+		ServerReply: "REJECTED EXTERNAL",
+	}
+	if diff := cmp.Diff(want, *authErr); diff != "" {
+		t.Error(diff)
+	}
+
+	errMsg := `auth EXTERNAL: expected OK, got "REJECTED EXTERNAL"`
+	if errMsg != err.Error() {
+		t.Errorf("expected error %q got %q", errMsg, err)
+	}
+}
+
+func TestAuthExternalUID(t *testing.T) {
+	tt := map[string]struct {
+		uid  int
+		want string
+	}{
+		"root": {uid: 0, want: "AUTH EXTERNAL 30\r\n"},
+		"user": {uid: 1000, want: "AUTH EXTERNAL 31303030\r\n"},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			const authResp = "OK eb50e12940d90495b897de9f64090a3e\r\n"
+			got := bytes.Buffer{}
+			w := bufio.NewWriter(&got)
+			rw := bufio.NewReadWriter(
+				bufio.NewReader(bytes.NewBufferString(authResp)),
+				w,
+			)
+			if err := authenticate(rw, []string{AuthExternal}, tc.uid, false); err != nil {
+				t.Fatal(err)
+			}
+			w.Flush()
+
+			want := "\x00" + tc.want + "BEGIN\r\n"
+			if diff := cmp.Diff(want, got.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestWithAuthUID(t *testing.T) {
+	tt := map[string]struct {
+		opts []Option
+		want int
+	}{
+		"default":  {want: -1},
+		"root":     {opts: []Option{WithAuthUID(0)}, want: 0},
+		"negative": {opts: []Option{WithAuthUID(-5)}, want: -1},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			c := newClient(tc.opts)
+			if tc.want != c.conf.authUID {
+				t.Errorf("expected uid %d got %d", tc.want, c.conf.authUID)
+			}
+		})
+	}
+}
+
+func TestAuthenticateFallback(t *testing.T) {
+	uid := hex.EncodeToString([]byte(strconv.Itoa(os.Geteuid())))
+	tt := map[string]struct {
+		mechs    []string
+		authResp string
+		want     string
+	}{
+		"next mechanism": {
+			mechs:    []string{AuthExternal, AuthAnonymous},
+			authResp: "REJECTED DBUS_COOKIE_SHA1 ANONYMOUS\r\nOK eb50e12940d90495b897de9f64090a3e\r\n",
+			want:     "\x00AUTH EXTERNAL " + uid + "\r\nAUTH ANONYMOUS\r\nBEGIN\r\n",
+		},
+		"mechanism not offered": {
+			mechs:    []string{AuthExternal, AuthCookieSHA1, AuthAnonymous},
+			authResp: "REJECTED ANONYMOUS\r\nOK eb50e12940d90495b897de9f64090a3e\r\n",
+			want:     "\x00AUTH EXTERNAL " + uid + "\r\nAUTH ANONYMOUS\r\nBEGIN\r\n",
+		},
+		"unsupported mechanism": {
+			mechs:    []string{"KERBEROS_V4", AuthExternal},
+			authResp: "OK eb50e12940d90495b897de9f64090a3e\r\n",
+			want:     "\x00AUTH EXTERNAL " + uid + "\r\nBEGIN\r\n",
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			got := bytes.Buffer{}
+			w := bufio.NewWriter(&got)
+			rw := bufio.NewReadWriter(
+				bufio.NewReader(bytes.NewBufferString(tc.authResp)),
+				w,
+			)
+			if err := authenticate(rw, tc.mechs, os.Geteuid(), false); err != nil {
+				t.Fatal(err)
+			}
+			w.Flush()
+
+			if diff := cmp.Diff(tc.want, got.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAuthenticateRejected(t *testing.T) {
+	const authResp = "REJECTED EXTERNAL ANONYMOUS\r\nREJECTED EXTERNAL ANONYMOUS\r\n"
+	rw := bufio.NewReadWriter(
+		bufio.NewReader(bytes.NewBufferString(authResp)),
+		bufio.NewWriter(io.Discard),
+	)
+	err := authenticate(rw, []string{AuthExternal, AuthAnonymous}, os.Geteuid(), false)
+
+	joinErr, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("expected joined error got %v", err)
+	}
+	var got []AuthError
+	for _, err := range joinErr.Unwrap() {
+		var authErr *AuthError
+		if !errors.As(err, &authErr) {
+			t.Fatalf("expected AuthError got %v", err)
+		}
+		got = append(got, *authErr)
+	}
+	want := []AuthError{
+		{Mechanism: AuthExternal, ServerReply: "REJECTED EXTERNAL ANONYMOUS"},
+		{Mechanism: AuthAnonymous, ServerReply: "REJECTED EXTERNAL ANONYMOUS"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestAuthenticateCookieSHA1(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	if err := os.Mkdir(filepath.Join(home, ".dbus-keyrings"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	keyring := "1 1700000000 0a1b2c\n7 1700000300 3d4e5f\n"
+	if err := os.WriteFile(filepath.Join(home, ".dbus-keyrings", "org_freedesktop_general"), []byte(keyring), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tt := map[string]struct {
+		cookieID string
+		authResp string
+		// wantMech is the mechanism the Client authenticated with.
+		wantMech string
+	}{
+		"cookie": {
+			cookieID: "7",
+			authResp: "OK eb50e12940d90495b897de9f64090a3e\r\n",
+			wantMech: AuthCookieSHA1,
+		},
+		// The Client cancels the exchange and tries the next mechanism.
+		"cookie not found": {
+			cookieID: "8",
+			authResp: "REJECTED ANONYMOUS\r\nOK eb50e12940d90495b897de9f64090a3e\r\n",
+			wantMech: AuthAnonymous,
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			challenge := "org_freedesktop_general " + tc.cookieID + " 5f4dcc3b5aa765d6"
+			authResp := "DATA " + hex.EncodeToString([]byte(challenge)) + "\r\n" + tc.authResp
+			got := bytes.Buffer{}
+			w := bufio.NewWriter(&got)
+			rw := bufio.NewReadWriter(
+				bufio.NewReader(bytes.NewBufferString(authResp)),
+				w,
+			)
+			if err := authenticate(rw, []string{AuthCookieSHA1, AuthAnonymous}, 1000, false); err != nil {
+				t.Fatal(err)
+			}
+			w.Flush()
+
+			lines := strings.Split(strings.TrimPrefix(got.String(), "\x00"), "\r\n")
+			if lines[0] != "AUTH DBUS_COOKIE_SHA1 31303030" {
+				t.Fatalf("unexpected auth line %q", lines[0])
+			}
+			if tc.wantMech == AuthAnonymous {
+				want := []string{"AUTH DBUS_COOKIE_SHA1 31303030", "CANCEL", "AUTH ANONYMOUS", "BEGIN", ""}
+				if diff := cmp.Diff(want, lines); diff != "" {
+					t.Error(diff)
+				}
+				return
+			}
+
+			// The response is "<client challenge> <sha1 hex>".
+			data, err := hex.DecodeString(strings.TrimPrefix(lines[1], "DATA "))
+			if err != nil {
+				t.Fatal(err)
+			}
+			clientChallenge, gotSum, _ := strings.Cut(string(data), " ")
+			sum := sha1.Sum([]byte("5f4dcc3b5aa765d6:" + clientChallenge + ":3d4e5f"))
+			if wantSum := hex.EncodeToString(sum[:]); wantSum != gotSum {
+				t.Errorf("expected sha1 %s got %s", wantSum, gotSum)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package systemd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	// cgroupRoot is where the cgroup file system is mounted.
+	cgroupRoot = "/sys/fs/cgroup"
+	// procRoot is where the proc file system is mounted.
+	procRoot = "/proc"
+)
+
+// readCgroupProcesses returns the processes of the control group cgroup,
+// e.g., "/system.slice/dbus.service", and its child groups
+// similar to systemd GetUnitProcesses method.
+// The cgroup is looked up in the unified hierarchy (cgroup v2) first,
+// then in the systemd's named hierarchy (cgroup v1).
+// The processes which exited and the child groups which were removed
+// while being read are skipped.
+func readCgroupProcesses(cgRoot, pRoot, cgroup string) ([]UnitProcess, error) {
+	dir := filepath.Join(cgRoot, cgroup)
+	if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+		dir = filepath.Join(cgRoot, "systemd", cgroup)
+	}
+
+	var procs []UnitProcess
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		// The cgroup itself must exist unlike its child groups.
+		if path != dir && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(filepath.Join(path, "cgroup.procs"))
+		if path != dir && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		cgPath := cgroup + filepath.ToSlash(strings.TrimPrefix(path, dir))
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			pid, err := strconv.ParseUint(s.Text(), 10, 32)
+			if err != nil {
+				return fmt.Errorf("parse pid in %s: %w", f.Name(), err)
+			}
+
+			cmd, err := processCommand(pRoot, pid)
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			procs = append(procs, UnitProcess{
+				CgroupPath: cgPath,
+				PID:        uint32(pid),
+				Command:    cmd,
+			})
+		}
+
+		return s.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return procs, nil
+}
+
+// processCommand returns the command line of the process
+// with the arguments separated by spaces.
+// Kernel threads have no command line,
+// so their name is returned in brackets, e.g., "[kthreadd]".
+func processCommand(pRoot string, pid uint64) (string, error) {
+	dir := filepath.Join(pRoot, strconv.FormatUint(pid, 10))
+
+	b, err := os.ReadFile(filepath.Join(dir, "cmdline"))
+	if err != nil {
+		return "", err
+	}
+	b = bytes.TrimRight(b, "\x00")
+	if len(b) > 0 {
+		return string(bytes.ReplaceAll(b, []byte{0}, []byte{' '})), nil
+	}
+
+	if b, err = os.ReadFile(filepath.Join(dir, "comm")); err != nil {
+		return "", err
+	}
+	return "[" + string(bytes.TrimSpace(b)) + "]", nil
+}
@@ -0,0 +1,98 @@
+package systemd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeCgroupFS creates the cgroup and proc file systems
+// with the processes of dbus.service in the temporary directory
+// and returns their roots.
+// The cgroup is created in the named hierarchy if v1 is set.
+func fakeCgroupFS(t *testing.T, v1 bool) (cgRoot, pRoot string) {
+	root := t.TempDir()
+	cgRoot = filepath.Join(root, "cgroup")
+	pRoot = filepath.Join(root, "proc")
+
+	unitDir := filepath.Join(cgRoot, "system.slice", "dbus.service")
+	if v1 {
+		unitDir = filepath.Join(cgRoot, "systemd", "system.slice", "dbus.service")
+	}
+	files := map[string]string{
+		// The process 1300 exited before its command line was read.
+		filepath.Join(unitDir, "cgroup.procs"):           "1241\n1300\n",
+		filepath.Join(unitDir, "helper", "cgroup.procs"): "1250\n",
+		filepath.Join(pRoot, "1241", "cmdline"):          "/usr/bin/dbus-daemon\x00--system\x00",
+		filepath.Join(pRoot, "1250", "cmdline"):          "",
+		filepath.Join(pRoot, "1250", "comm"):             "kworker/0:1\n",
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return cgRoot, pRoot
+}
+
+func TestReadCgroupProcesses(t *testing.T) {
+	want := []UnitProcess{
+		{CgroupPath: "/system.slice/dbus.service", PID: 1241, Command: "/usr/bin/dbus-daemon --system"},
+		{CgroupPath: "/system.slice/dbus.service/helper", PID: 1250, Command: "[kworker/0:1]"},
+	}
+
+	tt := map[string]bool{
+		"v2": false,
+		"v1": true,
+	}
+	for name, v1 := range tt {
+		t.Run(name, func(t *testing.T) {
+			cgRoot, pRoot := fakeCgroupFS(t, v1)
+
+			got, err := readCgroupProcesses(cgRoot, pRoot, "/system.slice/dbus.service")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestReadCgroupProcessesRemovedGroup(t *testing.T) {
+	cgRoot, pRoot := fakeCgroupFS(t, false)
+	// The child group was removed after it had been listed,
+	// so it has no cgroup.procs file.
+	err := os.Mkdir(filepath.Join(cgRoot, "system.slice", "dbus.service", "removed"), 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readCgroupProcesses(cgRoot, pRoot, "/system.slice/dbus.service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []UnitProcess{
+		{CgroupPath: "/system.slice/dbus.service", PID: 1241, Command: "/usr/bin/dbus-daemon --system"},
+		{CgroupPath: "/system.slice/dbus.service/helper", PID: 1250, Command: "[kworker/0:1]"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestReadCgroupProcessesNotFound(t *testing.T) {
+	cgRoot, pRoot := fakeCgroupFS(t, false)
+
+	_, err := readCgroupProcesses(cgRoot, pRoot, "/system.slice/blah.service")
+	if !os.IsNotExist(err) {
+		t.Errorf("expected not exist error got %v", err)
+	}
+}
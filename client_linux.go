@@ -0,0 +1,64 @@
+package systemd
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// GetUnitByPIDFD looks up the unit that the process referred by pidfd belongs to.
+// It returns the unit object path, the unit name, and its invocation ID.
+// Note, there is no cgroup in the result, because systemd replies with
+// the signature "osay", i.e., the object path, the unit name,
+// and the invocation ID bytes which are formatted here as a hex string.
+// The cgroup can be looked up by the unit name, e.g., with ControlGroup property.
+// Unlike a lookup by PID, it is not prone to PID reuse races.
+// A pidfd can be obtained with pidfd_open(2), see unix.PidfdOpen.
+//
+// The Client must be created with WithUnixFDs option
+// because the pidfd is sent to systemd out-of-band.
+// Note, GetUnitByPIDFD method was added in systemd v253.
+func (c *Client) GetUnitByPIDFD(pidfd int, opts ...CallOption) (path, unitID, invocationID string, err error) {
+	if !c.conf.isUnixFDEnabled {
+		return "", "", "", fmt.Errorf("unix fd passing is not enabled")
+	}
+
+	err = c.call("GetUnitByPIDFD", opts,
+		func(conn io.Writer, serial uint32) error {
+			return c.msgEnc.EncodeGetUnitByPIDFD(conn, pidfd, serial)
+		},
+		func(conn io.Reader) (err error) {
+			path, unitID, invocationID, err = c.msgDec.DecodeGetUnitByPIDFD(conn)
+			return err
+		},
+	)
+	return path, unitID, invocationID, err
+}
+
+// DumpByFileDescriptor returns the dump of the manager state similar to Dump,
+// but the dump is read from a memfd received from systemd,
+// so it doesn't pass through the connection buffers.
+// The caller is responsible for closing the returned file.
+//
+// The Client must be created with WithUnixFDs option.
+func (c *Client) DumpByFileDescriptor(opts ...CallOption) (*os.File, error) {
+	if !c.conf.isUnixFDEnabled {
+		return nil, fmt.Errorf("unix fd passing is not enabled")
+	}
+
+	var fd int
+	err := c.call("DumpByFileDescriptor", opts,
+		func(conn io.Writer, serial uint32) error {
+			return c.msgEnc.EncodeDump(conn, "DumpByFileDescriptor", serial)
+		},
+		func(conn io.Reader) (err error) {
+			fd, err = c.msgDec.DecodeUnixFD(conn)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.NewFile(uintptr(fd), "systemd-dump"), nil
+}
@@ -0,0 +1,2813 @@
+package systemd
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDialContext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bus")
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	conn, err := DialContext(context.Background(), "unix:path="+path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if _, ok := conn.(*net.UnixConn); !ok {
+		t.Errorf("expected *net.UnixConn got %T", conn)
+	}
+}
+
+func TestDialContextError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bus")
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	t.Run("address", func(t *testing.T) {
+		_, err := DialContext(context.Background(), "tcp:host=localhost,port=1234")
+		errMsg := "dbus address not found"
+		if err == nil || errMsg != err.Error() {
+			t.Fatalf("expected error %q got %q", errMsg, err)
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := DialContext(ctx, "unix:path="+path)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled got %v", err)
+		}
+	})
+}
+
+func TestBusAddress(t *testing.T) {
+	tt := map[string]struct {
+		env  string
+		want string
+	}{
+		"unset": {
+			env:  "",
+			want: "unix:path=/var/run/dbus/system_bus_socket",
+		},
+		"blank": {
+			env:  "  ",
+			want: "unix:path=/var/run/dbus/system_bus_socket",
+		},
+		"path": {
+			env:  "unix:path=/run/dbus/bus",
+			want: "unix:path=/run/dbus/bus",
+		},
+		"path with guid": {
+			env:  "unix:guid=7b5c,path=/run/dbus/bus",
+			want: "unix:path=/run/dbus/bus",
+		},
+		"list": {
+			env:  "tcp:host=localhost,port=1234;unix:path=/run/dbus/bus",
+			want: "unix:path=/run/dbus/bus",
+		},
+		"unsupported transport": {
+			env:  "tcp:host=localhost,port=1234",
+			want: "unix:path=/var/run/dbus/system_bus_socket",
+		},
+		"abstract socket": {
+			env:  "unix:abstract=/tmp/dbus-x",
+			want: "unix:path=/var/run/dbus/system_bus_socket",
+		},
+		"empty path": {
+			env:  "unix:path=",
+			want: "unix:path=/var/run/dbus/system_bus_socket",
+		},
+		"malformed": {
+			env:  "/run/dbus/bus",
+			want: "unix:path=/var/run/dbus/system_bus_socket",
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("DBUS_SYSTEM_BUS_ADDRESS", tc.env)
+
+			if got := BusAddress(); got != tc.want {
+				t.Errorf("expected %q got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSessionBusAddress(t *testing.T) {
+	t.Run("env", func(t *testing.T) {
+		t.Setenv("DBUS_SESSION_BUS_ADDRESS", "unix:path=/tmp/dbus/bus,guid=7b5c")
+		t.Setenv("XDG_RUNTIME_DIR", "/run/user/1000")
+
+		want := "unix:path=/tmp/dbus/bus"
+		if got := SessionBusAddress(); got != want {
+			t.Errorf("expected %q got %q", want, got)
+		}
+	})
+
+	t.Run("runtime dir", func(t *testing.T) {
+		t.Setenv("DBUS_SESSION_BUS_ADDRESS", "")
+		t.Setenv("XDG_RUNTIME_DIR", "/run/user/1000")
+
+		want := "unix:path=/run/user/1000/bus"
+		if got := SessionBusAddress(); got != want {
+			t.Errorf("expected %q got %q", want, got)
+		}
+	})
+
+	t.Run("default", func(t *testing.T) {
+		t.Setenv("DBUS_SESSION_BUS_ADDRESS", "")
+		t.Setenv("XDG_RUNTIME_DIR", "")
+
+		want := "unix:path=/run/user/" + strconv.Itoa(os.Geteuid()) + "/bus"
+		if got := SessionBusAddress(); got != want {
+			t.Errorf("expected %q got %q", want, got)
+		}
+	})
+}
+
+func TestClientBusType(t *testing.T) {
+	t.Setenv("DBUS_SYSTEM_BUS_ADDRESS", "")
+	t.Setenv("DBUS_SESSION_BUS_ADDRESS", "unix:path=/tmp/dbus/bus")
+	t.Setenv("XDG_RUNTIME_DIR", "/run/user/1000")
+
+	tt := map[string]struct {
+		opts []Option
+		want BusType
+	}{
+		"default": {
+			want: BusSystem,
+		},
+		"system": {
+			opts: []Option{WithAddress("unix:path=/run/dbus/system_bus_socket")},
+			want: BusSystem,
+		},
+		"session env": {
+			opts: []Option{WithAddress(SessionBusAddress())},
+			want: BusSession,
+		},
+		"session runtime dir": {
+			opts: []Option{WithAddress("unix:path=/run/user/1000/bus,guid=7b5c")},
+			want: BusSession,
+		},
+		"custom": {
+			opts: []Option{WithAddress("unix:path=/tmp/custom/bus")},
+			want: BusUnknown,
+		},
+		"malformed": {
+			opts: []Option{WithAddress("/run/dbus/bus")},
+			want: BusUnknown,
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			c := newClient(tc.opts)
+			if got := c.BusType(); got != tc.want {
+				t.Errorf("expected %s got %s", tc.want, got)
+			}
+			if got := c.IsSystemBus(); got != (tc.want == BusSystem) {
+				t.Errorf("expected system bus %t got %t", tc.want == BusSystem, got)
+			}
+		})
+	}
+}
+
+// silentBus starts a message bus which accepts connections,
+// but never replies, and returns its address.
+func silentBus(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "bus")
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+
+	return "unix:path=" + path
+}
+
+// helloBus starts a message bus which replies to auth, Hello,
+// and a single Ping on each connection.
+// It returns its address and a channel of the accepted connections.
+func helloBus(t *testing.T) (string, <-chan net.Conn) {
+	path := filepath.Join(t.TempDir(), "bus")
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	accepted := make(chan net.Conn, 10)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { conn.Close() })
+			accepted <- conn
+
+			conn.Write([]byte("OK bde8d2222a9e966420ee8c1a63e972b4\r\n"))
+			conn.Write(helloResponse)
+			conn.Write(pingResponse)
+			go io.Copy(io.Discard, conn)
+		}
+	}()
+
+	return "unix:path=" + path, accepted
+}
+
+func TestClientReconnect(t *testing.T) {
+	addr, accepted := helloBus(t)
+
+	c, err := New(WithAddress(addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err = c.Ping(); err != nil {
+		t.Fatal(err)
+	}
+	<-accepted
+
+	if err = c.Reconnect(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-accepted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a new connection")
+	}
+	// The serial starts over from the Hello message.
+	if c.msgSerial != 1 {
+		t.Errorf("expected serial 1 got %d", c.msgSerial)
+	}
+	if err = c.Ping(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientBufferPool(t *testing.T) {
+	b := newFakeBus(t)
+	b.Reply("ListUnits", fakeListUnits(expectedServices...))
+
+	// New is called by the Client's goroutine when the pool is empty.
+	var created int
+	pool := &sync.Pool{
+		New: func() any {
+			created++
+			return &bytes.Buffer{}
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		c, err := New(WithAddress(b.Addr), WithBufferPool(pool))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer c.Close()
+
+		var got []Unit
+		err = c.ListUnits(IsService, func(u *Unit) {
+			got = append(got, *u)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(expectedServices, got); diff != "" {
+			t.Error(diff)
+		}
+
+		// The values were read into the borrowed buffer.
+		if n := c.msgDec.Dec.buf.Cap(); n != 0 {
+			t.Errorf("expected the own buffer to stay empty, got %d bytes", n)
+		}
+	}
+
+	if created == 0 {
+		t.Error("expected the buffer to be borrowed from the pool")
+	}
+}
+
+func TestClientConnectionFactory(t *testing.T) {
+	b := newFakeBus(t)
+	b.Reply("Get", fakeUint32Property(2375))
+
+	var dials int
+	factory := func(ctx context.Context) (net.Conn, error) {
+		dials++
+		client, server := net.Pipe()
+		go b.Serve(server)
+		return client, nil
+	}
+	// The bus address is ignored in favor of the factory.
+	c, err := New(WithAddress("unix:path=/nonexistent"), WithConnectionFactory(factory))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if err = c.Reconnect(); err != nil {
+		t.Fatal(err)
+	}
+	if dials != 2 {
+		t.Errorf("expected 2 dials got %d", dials)
+	}
+
+	pid, err := c.MainPID("dbus.service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pid != 2375 {
+		t.Errorf("expected pid 2375 got %d", pid)
+	}
+}
+
+func TestClientConnectionFactoryError(t *testing.T) {
+	factory := func(ctx context.Context) (net.Conn, error) {
+		return nil, errors.New("tunnel is down")
+	}
+	_, err := New(WithConnectionFactory(factory))
+	if err == nil || err.Error() != "tunnel is down" {
+		t.Fatalf("expected factory error got %v", err)
+	}
+}
+
+func TestClientReconnectError(t *testing.T) {
+	addr, _ := helloBus(t)
+
+	c, err := New(WithAddress(addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// The bus is gone.
+	c.conf.busAddr = "unix:path=" + filepath.Join(t.TempDir(), "bus")
+	if err = c.Reconnect(); err == nil {
+		t.Fatal("expected error")
+	}
+	if err = c.Ping(); err == nil {
+		t.Error("expected closed connection")
+	}
+}
+
+func TestClientCloseKeepAlive(t *testing.T) {
+	// The ping reply never comes, so the keep-alive goroutine
+	// is blocked until the connection is closed.
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	replies := io.MultiReader(
+		strings.NewReader("OK bde8d2222a9e966420ee8c1a63e972b4\r\n"),
+		bytes.NewReader(helloResponse),
+		pr,
+	)
+	conn := &pipeConn{replayConn: replayConn{replies: replies}, pr: pr}
+
+	c, err := NewWithConn(conn, WithKeepAlive(time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for start := time.Now(); !c.isPinging.Load(); {
+		if time.Since(start) > time.Second {
+			t.Fatal("expected ping")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if err = c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !waitTimeout(&c.wg, time.Second) {
+		t.Error("expected the keep-alive goroutine to exit")
+	}
+	if c.isPinging.Load() {
+		t.Error("expected the ping to be stopped")
+	}
+}
+
+func TestNewContextDeadline(t *testing.T) {
+	addr := silentBus(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := NewContext(ctx, WithAddress(addr), WithTimeout(time.Minute))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("auth wasn't interrupted in time: %s", elapsed)
+	}
+}
+
+func TestNewContextCanceled(t *testing.T) {
+	addr := silentBus(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := NewContext(ctx, WithAddress(addr), WithTimeout(time.Minute))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("auth wasn't interrupted in time: %s", elapsed)
+	}
+}
+
+// replayConn is a connection which replays the recorded replies
+// and discards the requests keeping only their serials.
+type replayConn struct {
+	net.Conn
+	replies io.Reader
+	serials []uint32
+}
+
+func (c *replayConn) Read(b []byte) (int, error) { return c.replies.Read(b) }
+
+func (c *replayConn) Write(b []byte) (int, error) {
+	// The auth lines are skipped, the messages are little-endian.
+	if len(b) >= msgPrologueSize && b[0] == littleEndian {
+		c.serials = append(c.serials, binary.LittleEndian.Uint32(b[8:12]))
+	}
+	return len(b), nil
+}
+
+func (c *replayConn) SetDeadline(t time.Time) error      { return nil }
+func (c *replayConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *replayConn) SetWriteDeadline(t time.Time) error { return nil }
+
+func TestClientCollectStats(t *testing.T) {
+	const delay = 20 * time.Millisecond
+	conn := &replayConn{replies: &delayReader{
+		r:     bytes.NewReader(listUnitsResponse[:35794]),
+		delay: delay,
+	}}
+	c := newClient(nil)
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	var stats CallStats
+	err := c.ListUnits(nil, func(u *Unit) {}, CollectStats(&stats))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := len(listUnitsRequest) + 35794; stats.WireBytes != want {
+		t.Errorf("expected %d wire bytes got %d", want, stats.WireBytes)
+	}
+	if stats.WaitDuration < delay {
+		t.Errorf("expected wait duration at least %s got %s", delay, stats.WaitDuration)
+	}
+	if stats.EncodeDuration <= 0 || stats.DecodeDuration <= 0 {
+		t.Errorf("expected positive durations got %+v", stats)
+	}
+	if total := stats.EncodeDuration + stats.WaitDuration + stats.DecodeDuration; stats.Latency < total {
+		t.Errorf("expected latency at least %s got %s", total, stats.Latency)
+	}
+}
+
+// delayReader delays the first read from r,
+// e.g., to imitate a slow reply.
+type delayReader struct {
+	r       io.Reader
+	delay   time.Duration
+	delayed bool
+}
+
+func (d *delayReader) Read(b []byte) (int, error) {
+	if !d.delayed {
+		d.delayed = true
+		time.Sleep(d.delay)
+	}
+	return d.r.Read(b)
+}
+
+// slowReader reads at most chunk bytes at a time
+// and sleeps before each read similar to a slow connection.
+type slowReader struct {
+	r     io.Reader
+	chunk int
+	delay time.Duration
+}
+
+func (s *slowReader) Read(b []byte) (int, error) {
+	time.Sleep(s.delay)
+	if len(b) > s.chunk {
+		b = b[:s.chunk]
+	}
+	return s.r.Read(b)
+}
+
+func TestClientListUnitsContext(t *testing.T) {
+	// Reading the whole reply takes at least 140 * 5ms = 700ms.
+	conn := &replayConn{replies: &slowReader{
+		r:     bytes.NewReader(listUnitsResponse[:35794]),
+		chunk: 256,
+		delay: 5 * time.Millisecond,
+	}}
+	c := newClient(nil)
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	const timeout = 50 * time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var units int
+	start := time.Now()
+	err := c.ListUnitsContext(ctx, nil, func(u *Unit) {
+		units++
+	})
+	elapsed := time.Since(start)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded got %v", err)
+	}
+	if elapsed > 6*timeout {
+		t.Errorf("expected the call to return near the deadline, took %s", elapsed)
+	}
+	if units == 0 || units >= 156 {
+		t.Errorf("expected the decoding to stop midway, got %d units", units)
+	}
+	if c.msgDec.Ctx != nil {
+		t.Error("expected the decoder's context to be reset")
+	}
+}
+
+func TestClientListUnitsContextCanceled(t *testing.T) {
+	c := newClient(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := c.ListUnitsContext(ctx, nil, func(u *Unit) {})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled got %v", err)
+	}
+}
+
+func TestClientCallReadSize(t *testing.T) {
+	tt := map[string]struct {
+		opts      []CallOption
+		wantReads int
+	}{
+		// 35794 bytes message is read with 4096 bytes buffer.
+		"default": {
+			wantReads: 9,
+		},
+		"smaller than default": {
+			opts:      []CallOption{WithCallReadSize(1024)},
+			wantReads: 9,
+		},
+		"large": {
+			opts:      []CallOption{WithCallReadSize(64 * 1024)},
+			wantReads: 1,
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			// The recorded listUnitsResponse has zero bytes past the message.
+			replies := io.MultiReader(
+				bytes.NewReader(listUnitsResponse[:35794]),
+				bytes.NewReader(mainPIDResponse),
+			)
+			conn := &countingConn{replayConn: replayConn{replies: replies}}
+			// The buffer doesn't grow on its own to fit the reply.
+			c := newClient([]Option{WithMaxConnectionReadSize(0)})
+			c.conn = conn
+			c.bufConn.Reset(conn)
+
+			var n int
+			err := c.ListUnits(nil, func(*Unit) { n++ }, tc.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != 156 {
+				t.Errorf("expected 156 units got %d", n)
+			}
+			if conn.reads != tc.wantReads {
+				t.Errorf("expected %d reads got %d", tc.wantReads, conn.reads)
+			}
+
+			// The following call reads with the default buffer.
+			pid, err := c.MainPID("dbus.service")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pid != 2375 {
+				t.Errorf("expected pid 2375 got %d", pid)
+			}
+			if c.bufConn != c.baseBufConn {
+				t.Error("expected the base reader")
+			}
+		})
+	}
+}
+
+func TestClientCallTimeout(t *testing.T) {
+	tt := map[string]struct {
+		connTimeout time.Duration
+		opts        []CallOption
+		wantErr     error
+	}{
+		// The reply is late for the connection timeout.
+		"connection timeout": {
+			connTimeout: 50 * time.Millisecond,
+			wantErr:     os.ErrDeadlineExceeded,
+		},
+		"call timeout longer than connection timeout": {
+			connTimeout: 50 * time.Millisecond,
+			opts:        []CallOption{WithCallTimeout(5 * time.Second)},
+		},
+		"call timeout shorter than connection timeout": {
+			connTimeout: 5 * time.Second,
+			opts:        []CallOption{WithCallTimeout(50 * time.Millisecond)},
+			wantErr:     os.ErrDeadlineExceeded,
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			b := newFakeBus(t)
+			b.Handle("ListUnits", func(call fakeCall) fakeReply {
+				time.Sleep(200 * time.Millisecond)
+				return fakeListUnits(Unit{Name: "dbus.service"})
+			})
+			b.Handle("Get", func(call fakeCall) fakeReply {
+				time.Sleep(200 * time.Millisecond)
+				return fakeUint32Property(2375)
+			})
+
+			c, err := New(WithAddress(b.Addr), WithTimeout(tc.connTimeout))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer c.Close()
+
+			err = c.ListUnits(nil, func(*Unit) {}, tc.opts...)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("ListUnits: expected error %v got %v", tc.wantErr, err)
+			}
+			if tc.wantErr != nil {
+				// The late reply can't be told apart from the next one.
+				if err = c.Reconnect(); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err = c.MainPID("dbus.service", tc.opts...)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("MainPID: expected error %v got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestClientCallFlags(t *testing.T) {
+	b := newFakeBus(t)
+	b.Reply("ListUnits", fakeListUnits(Unit{Name: "dbus.service"}))
+	b.Reply("Get", fakeUint32Property(2375))
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	opts := []CallOption{
+		WithCallFlags(NoAutoStart),
+		WithCallFlags(AllowInteractiveAuthorization),
+		WithCallDestination(":1.5"),
+	}
+	if err = c.ListUnits(nil, func(*Unit) {}, opts...); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.MainPID("dbus.service", opts...); err != nil {
+		t.Fatal(err)
+	}
+	// The options don't stick to the following calls.
+	if _, err = c.MainPID("dbus.service"); err != nil {
+		t.Fatal(err)
+	}
+
+	type callHeader struct {
+		Member      string
+		Destination string
+		Flags       byte
+	}
+	var got []callHeader
+	for _, call := range b.Calls()[1:] {
+		got = append(got, callHeader{
+			Member:      call.Member,
+			Destination: call.Destination,
+			Flags:       call.Flags,
+		})
+	}
+	want := []callHeader{
+		{Member: "ListUnits", Destination: ":1.5", Flags: NoAutoStart | AllowInteractiveAuthorization},
+		{Member: "Get", Destination: ":1.5", Flags: NoAutoStart | AllowInteractiveAuthorization},
+		{Member: "Get", Destination: "org.freedesktop.systemd1"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestClientGrowReadSize(t *testing.T) {
+	// The following reply is read along with the large one,
+	// so it must be carried over to the next call.
+	var replies []byte
+	replies = append(replies, largeListUnitsResponse(30)...)
+	replies = append(replies, mainPIDResponse...)
+	replies = append(replies, listUnitsResponse[:35794]...)
+
+	tt := map[string]struct {
+		opts      []Option
+		wantReads int
+	}{
+		"no growth": {
+			opts:      []Option{WithMaxConnectionReadSize(0)},
+			wantReads: 262,
+		},
+		"default": {
+			wantReads: 3,
+		},
+		"limited": {
+			opts:      []Option{WithMaxConnectionReadSize(64 * 1024)},
+			wantReads: 18,
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conn := &countingConn{replayConn: replayConn{replies: bytes.NewReader(replies)}}
+			c := newClient(tc.opts)
+			c.conn = conn
+			c.bufConn.Reset(conn)
+
+			var n int
+			if err := c.ListUnits(nil, func(*Unit) { n++ }); err != nil {
+				t.Fatal(err)
+			}
+			if n != 30*156 {
+				t.Errorf("expected %d units got %d", 30*156, n)
+			}
+			if conn.reads != tc.wantReads {
+				t.Errorf("expected %d reads got %d", tc.wantReads, conn.reads)
+			}
+
+			pid, err := c.MainPID("dbus.service")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pid != 2375 {
+				t.Errorf("expected pid 2375 got %d", pid)
+			}
+
+			n = 0
+			if err = c.ListUnits(nil, func(*Unit) { n++ }); err != nil {
+				t.Fatal(err)
+			}
+			if n != 156 {
+				t.Errorf("expected 156 units got %d", n)
+			}
+			if _, err = c.bufConn.ReadByte(); !errors.Is(err, io.EOF) {
+				t.Errorf("conn has unread bytes")
+			}
+		})
+	}
+}
+
+func BenchmarkClientGrowReadSize(b *testing.B) {
+	reply := largeListUnitsResponse(30)
+	tt := map[string][]Option{
+		"no growth": {WithMaxConnectionReadSize(0)},
+		"default":   nil,
+	}
+
+	for name, opts := range tt {
+		b.Run(name, func(b *testing.B) {
+			conn := &countingConn{}
+			c := newClient(opts)
+			c.conn = conn
+			c.bufConn.Reset(conn)
+			r := bytes.NewReader(reply)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				r.Reset(reply)
+				conn.replies = r
+				if err := c.ListUnits(nil, func(*Unit) {}); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(conn.reads)/float64(b.N), "reads/op")
+		})
+	}
+}
+
+// largeListUnitsResponse makes a reply to ListUnits method
+// with the units of listUnitsResponse repeated n times,
+// e.g., n=30 results in 1MB message with 4680 units.
+func largeListUnitsResponse(n int) []byte {
+	const (
+		// The array length is followed by the padding to the first struct.
+		bodyOffset  = 80
+		unitsOffset = bodyOffset + 8
+		// The recorded listUnitsResponse has zero bytes past the message.
+		msgLen = 35794
+	)
+	units := listUnitsResponse[unitsOffset:msgLen]
+	// Each repeated array of units must start on an 8-byte boundary.
+	pad := (8 - len(units)%8) % 8
+
+	msg := append([]byte{}, listUnitsResponse[:unitsOffset]...)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			msg = append(msg, make([]byte, pad)...)
+		}
+		msg = append(msg, units...)
+	}
+
+	arrLen := len(msg) - unitsOffset
+	binary.LittleEndian.PutUint32(msg[bodyOffset:], uint32(arrLen))
+	binary.LittleEndian.PutUint32(msg[4:], uint32(len(msg)-bodyOffset))
+	return msg
+}
+
+// countingConn is a replayConn which counts the reads.
+type countingConn struct {
+	replayConn
+	reads int
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	c.reads++
+	return c.replayConn.Read(b)
+}
+
+func TestClientConsecutiveReplies(t *testing.T) {
+	// The replies are read by the client at once,
+	// so the following reply is already buffered
+	// while the current one is decoded.
+	var replies []byte
+	// The recorded listUnitsResponse has zero bytes past the message,
+	// i.e., 35794 = 16 head + 61 header + 3 padding + 35714 body.
+	replies = append(replies, listUnitsResponse[:35794]...)
+	replies = append(replies, startUnitNoSuchUnitArgsResponse...)
+	replies = append(replies, helloResponse...)
+	replies = append(replies, mainPIDResponse...)
+
+	conn := &replayConn{replies: bytes.NewReader(replies)}
+	c := newClient([]Option{WithConnectionReadSize(len(replies))})
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	var units int
+	err := c.ListUnits(IsService, func(u *Unit) { units++ })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if units != len(expectedServices) {
+		t.Errorf("expected %d units got %d", len(expectedServices), units)
+	}
+
+	_, err = c.StartUnit("nope.service", "replace")
+	if !errors.Is(err, ErrNoSuchUnit) {
+		t.Fatalf("expected ErrNoSuchUnit got %v", err)
+	}
+
+	// The body of the hello reply isn't 8-byte aligned,
+	// so the next message starts without padding.
+	if _, err = c.hello(); err != nil {
+		t.Fatal(err)
+	}
+
+	pid, err := c.MainPID("dbus")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want uint32 = 2375
+	if want != pid {
+		t.Errorf("expected pid %d got %d", want, pid)
+	}
+
+	if n := c.bufConn.Buffered(); n != 0 {
+		t.Errorf("expected no buffered bytes got %d", n)
+	}
+	if _, err = c.bufConn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+func TestClientHelloInvalidName(t *testing.T) {
+	tt := map[string]struct {
+		connName string
+		errMsg   string
+	}{
+		"empty": {
+			errMsg: "dbus Hello failed: unexpected Hello reply: unique connection name must not be empty",
+		},
+		"well-known name": {
+			connName: "org.freedesktop.systemd1",
+			errMsg:   `dbus Hello failed: unexpected Hello reply: unique connection name must begin with a colon: "org.freedesktop.systemd1"`,
+		},
+		"malformed": {
+			connName: ":1",
+			errMsg:   `dbus Hello failed: unexpected Hello reply: bus name must have at least two elements: ":1"`,
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			hello := fakeReply{
+				Signature: "s",
+				Body: func(enc *encoder) {
+					enc.String(tc.connName)
+				},
+			}
+			conn := &replayConn{replies: io.MultiReader(
+				strings.NewReader("OK bde8d2222a9e966420ee8c1a63e972b4\r\n"),
+				bytes.NewReader(encodeFakeReply(1, 1, tc.connName, hello)),
+			)}
+
+			_, err := NewWithConn(conn)
+			if err == nil || err.Error() != tc.errMsg {
+				t.Errorf("expected error %q got %v", tc.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestClientInitialSerial(t *testing.T) {
+	// Each reply is read separately,
+	// so the Client can be reset after receiving it.
+	replies := io.MultiReader(
+		strings.NewReader("OK bde8d2222a9e966420ee8c1a63e972b4\r\n"),
+		bytes.NewReader(helloResponse),
+		bytes.NewReader(mainPIDResponse),
+		bytes.NewReader(mainPIDResponse),
+	)
+	conn := &replayConn{replies: replies}
+
+	c, err := NewWithConn(conn, WithInitialSerial(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.MainPID("dbus"); err != nil {
+		t.Fatal(err)
+	}
+	// The serial keeps counting up after Reset.
+	if err = c.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.MainPID("dbus"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []uint32{100, 101, 102}
+	if diff := cmp.Diff(want, conn.serials); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestClientWithoutHello(t *testing.T) {
+	// The recorded reply answers the serial 3,
+	// so its copy is patched to answer the next call.
+	nextResponse := append([]byte(nil), mainPIDResponse...)
+	nextResponse[20] = 4
+	replies := io.MultiReader(
+		strings.NewReader("OK bde8d2222a9e966420ee8c1a63e972b4\r\n"),
+		bytes.NewReader(mainPIDResponse),
+		bytes.NewReader(nextResponse),
+	)
+	conn := &replayConn{replies: replies}
+
+	// The recorded reply is addressed to ":1.388",
+	// but the destination isn't checked without a connection name.
+	c, err := NewWithConn(conn, WithoutHello(), WithSerialCheck(), WithInitialSerial(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.MainPID("dbus"); err != nil {
+		t.Fatal(err)
+	}
+	// The serial keeps counting up after Reset.
+	if err = c.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.MainPID("dbus"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []uint32{3, 4}
+	if diff := cmp.Diff(want, conn.serials); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestClientResetReuse(t *testing.T) {
+	b := newFakeBus(t)
+	b.Reply("Get", fakeUint32Property(2375))
+
+	client, server := net.Pipe()
+	go b.Serve(server)
+
+	c, err := NewWithConn(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	for i := 0; i < 2; i++ {
+		pid, err := c.MainPID("dbus.service")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pid != 2375 {
+			t.Errorf("expected pid 2375 got %d", pid)
+		}
+		// The connection is reused without auth and Hello message.
+		if err = c.Reset(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var (
+		members []string
+		serials []uint32
+	)
+	for _, call := range b.Calls() {
+		members = append(members, call.Member)
+		serials = append(serials, call.Serial)
+	}
+	if diff := cmp.Diff([]string{"Hello", "Get", "Get"}, members); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff([]uint32{1, 2, 3}, serials); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestClientResetUnreadData(t *testing.T) {
+	// Both replies arrive in one read,
+	// so the second one stays in the buffer.
+	var replies []byte
+	replies = append(replies, mainPIDResponse...)
+	replies = append(replies, mainPIDResponse...)
+	conn := &replayConn{replies: io.MultiReader(
+		strings.NewReader("OK bde8d2222a9e966420ee8c1a63e972b4\r\n"),
+		bytes.NewReader(helloResponse),
+		bytes.NewReader(replies),
+	)}
+
+	c, err := NewWithConn(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.MainPID("dbus"); err != nil {
+		t.Fatal(err)
+	}
+
+	err = c.Reset()
+	want := "connection has unread data"
+	if err == nil || err.Error() != want {
+		t.Errorf("expected error %q got %v", want, err)
+	}
+}
+
+func TestClientResetBrokenConn(t *testing.T) {
+	b := newFakeBus(t)
+	b.Handle("Get", func(call fakeCall) fakeReply {
+		time.Sleep(100 * time.Millisecond)
+		return fakeUint32Property(2375)
+	})
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	_, err = c.MainPID("dbus.service", WithCallTimeout(10*time.Millisecond))
+	if !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Fatalf("expected os.ErrDeadlineExceeded got %v", err)
+	}
+
+	// The late reply would be mistaken for a reply to the next call,
+	// so the Client can't be reused.
+	err = c.Reset()
+	if err == nil || !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Fatalf("expected broken connection error got %v", err)
+	}
+
+	if err = c.Reconnect(); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	pid, err := c.MainPID("dbus.service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pid != 2375 {
+		t.Errorf("expected pid 2375 got %d", pid)
+	}
+}
+
+func TestWithInitialSerialZero(t *testing.T) {
+	var conf Config
+	WithInitialSerial(0)(&conf)
+
+	if conf.initialSerial != 1 {
+		t.Errorf("expected serial 1 got %d", conf.initialSerial)
+	}
+}
+
+func TestWithStrictDecoding(t *testing.T) {
+	c := newClient([]Option{WithStrictDecoding()})
+
+	if !c.msgDec.Strict {
+		t.Error("expected strict decoder")
+	}
+	if c.msgDec.SkipHeaderFields {
+		t.Error("expected header fields to be decoded")
+	}
+}
+
+func TestClientMainPIDAtPath(t *testing.T) {
+	replies := io.MultiReader(
+		bytes.NewReader(getUnitResponse),
+		bytes.NewReader(mainPIDResponse),
+	)
+	conn := &replayConn{replies: replies}
+	c := newClient(nil)
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	path, err := c.GetUnit("dbus.service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "/org/freedesktop/systemd1/unit/dbus_2eservice"; want != path {
+		t.Errorf("expected %q got %q", want, path)
+	}
+
+	pid, err := c.MainPIDAtPath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want uint32 = 2375
+	if want != pid {
+		t.Errorf("expected pid %d got %d", want, pid)
+	}
+}
+
+func TestClientMainPIDOf(t *testing.T) {
+	tests := map[string]struct {
+		replies []io.Reader
+		unit    Unit
+	}{
+		"path": {
+			replies: []io.Reader{bytes.NewReader(mainPIDResponse)},
+			unit: Unit{
+				Name: "dbus.service",
+				Path: "/org/freedesktop/systemd1/unit/dbus_2eservice",
+			},
+		},
+		"no path": {
+			replies: []io.Reader{
+				bytes.NewReader(getUnitResponse),
+				bytes.NewReader(mainPIDResponse),
+			},
+			unit: Unit{Name: "dbus.service"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			conn := &replayConn{replies: io.MultiReader(tc.replies...)}
+			c := newClient(nil)
+			c.conn = conn
+			c.bufConn.Reset(conn)
+
+			pid, err := c.MainPIDOf(&tc.unit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want uint32 = 2375
+			if want != pid {
+				t.Errorf("expected pid %d got %d", want, pid)
+			}
+			if want := "/org/freedesktop/systemd1/unit/dbus_2eservice"; want != tc.unit.Path {
+				t.Errorf("expected cached path %q got %q", want, tc.unit.Path)
+			}
+		})
+	}
+}
+
+func TestClientDump(t *testing.T) {
+	// The dump is much larger than the connection read buffer,
+	// so it's read in many chunks.
+	want := strings.Repeat("-> Unit dbus.service:\n\tDescription: D-Bus System Message Bus\n", 5000)
+	var buf bytes.Buffer
+	enc := newEncoder(&buf)
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodReply,
+		Flags:     1,
+		Proto:     1,
+		Serial:    2281,
+		Fields: []headerField{
+			{Signature: "u", U: 1, Code: fieldReplySerial},
+			{Signature: "g", S: "s", Code: fieldSignature},
+		},
+	}
+	if err := encodeHeader(enc, &h); err != nil {
+		t.Fatal(err)
+	}
+	bodyOff := enc.Offset()
+	enc.String(want)
+	if err := enc.Uint32At(enc.Offset()-bodyOff, 4); err != nil {
+		t.Fatal(err)
+	}
+
+	conn := &replayConn{replies: &buf}
+	c := newClient([]Option{WithConnectionReadSize(4096)})
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	got, err := c.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want != got {
+		t.Errorf("expected %d bytes got %d", len(want), len(got))
+	}
+	if _, err = c.bufConn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+func TestClientAllProperties(t *testing.T) {
+	introspection := `<!DOCTYPE node PUBLIC "-//freedesktop//DTD D-BUS Object Introspection 1.0//EN"
+"http://www.freedesktop.org/standards/dbus/1.0/introspect.dtd">
+<node>
+ <interface name="org.freedesktop.DBus.Peer">
+  <method name="Ping"/>
+ </interface>
+ <interface name="org.freedesktop.systemd1.Service">
+  <property name="MainPID" type="u" access="read"/>
+ </interface>
+ <interface name="org.freedesktop.systemd1.Unit">
+  <property name="Id" type="s" access="read"/>
+ </interface>
+</node>
+`
+	var buf bytes.Buffer
+	enc := newEncoder(&buf)
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodReply,
+		Flags:     1,
+		Proto:     1,
+		Serial:    2281,
+		Fields: []headerField{
+			{Signature: "u", U: 1, Code: fieldReplySerial},
+			{Signature: "g", S: "s", Code: fieldSignature},
+		},
+	}
+	if err := encodeHeader(enc, &h); err != nil {
+		t.Fatal(err)
+	}
+	bodyOff := enc.Offset()
+	enc.String(introspection)
+	if err := enc.Uint32At(enc.Offset()-bodyOff, 4); err != nil {
+		t.Fatal(err)
+	}
+
+	// The properties are fetched only for the systemd interfaces.
+	conn := &replayConn{replies: io.MultiReader(
+		&buf,
+		bytes.NewReader(getAllPropertiesResponse),
+		bytes.NewReader(unitPropertiesResponse),
+	)}
+	c := newClient(nil)
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	got, err := c.AllProperties("/org/freedesktop/systemd1/unit/dbus_2eservice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 interfaces got %d", len(got))
+	}
+	if pid := got["org.freedesktop.systemd1.Service"]["MainPID"].U; pid != 1241 {
+		t.Errorf("expected pid 1241 got %d", pid)
+	}
+	if id := got["org.freedesktop.systemd1.Unit"]["Id"].S; id != "dbus.service" {
+		t.Errorf("expected dbus.service got %q", id)
+	}
+	if len(conn.serials) != 3 {
+		t.Errorf("expected 3 requests got %d", len(conn.serials))
+	}
+	if _, err = c.bufConn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+func TestClientMultiGetAll(t *testing.T) {
+	objPath := "/org/freedesktop/systemd1/unit/dbus_2eservice"
+	unitProps := fakeStringProperties("Id", "dbus.service", "ActiveState", "active")
+	serviceProps := fakeStringProperties("Type", "notify")
+	noInterface := fakeError("org.freedesktop.DBus.Error.UnknownInterface", "Unknown interface.")
+
+	tt := map[string]struct {
+		ifaces  []string
+		replies []io.Reader
+		want    map[string]map[string]Variant
+		errMsg  string
+	}{
+		"out of order": {
+			ifaces: []string{"org.freedesktop.systemd1.Unit", "org.freedesktop.systemd1.Service"},
+			replies: []io.Reader{
+				bytes.NewReader(encodeFakeReply(10, 2, "", serviceProps)),
+				bytes.NewReader(encodeFakeReply(11, 1, "", unitProps)),
+			},
+			want: map[string]map[string]Variant{
+				"org.freedesktop.systemd1.Unit": {
+					"Id":          {Signature: "s", S: "dbus.service"},
+					"ActiveState": {Signature: "s", S: "active"},
+				},
+				"org.freedesktop.systemd1.Service": {
+					"Type": {Signature: "s", S: "notify"},
+				},
+			},
+		},
+		"no interfaces": {
+			want: map[string]map[string]Variant{},
+		},
+		"unknown interface": {
+			ifaces: []string{"org.freedesktop.systemd1.Unit", "org.freedesktop.systemd1.Scope", "org.freedesktop.systemd1.Service"},
+			replies: []io.Reader{
+				bytes.NewReader(encodeFakeReply(10, 1, "", unitProps)),
+				bytes.NewReader(encodeFakeReply(11, 2, "", noInterface)),
+				bytes.NewReader(encodeFakeReply(12, 3, "", serviceProps)),
+			},
+			errMsg: "decode GetAll org.freedesktop.systemd1.Scope: Unknown interface.",
+		},
+		"unexpected serial": {
+			ifaces: []string{"org.freedesktop.systemd1.Unit"},
+			replies: []io.Reader{
+				bytes.NewReader(encodeFakeReply(10, 7, "", unitProps)),
+			},
+			errMsg: "decode GetAll: unexpected reply serial 7",
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conn := &replayConn{replies: io.MultiReader(tc.replies...)}
+			c := newClient(nil)
+			c.conn = conn
+			c.bufConn.Reset(conn)
+
+			got, err := c.MultiGetAll(objPath, tc.ifaces)
+			if tc.errMsg != "" {
+				if err == nil || err.Error() != tc.errMsg {
+					t.Fatalf("expected error %q got %v", tc.errMsg, err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error(diff)
+			}
+			if len(conn.serials) != len(tc.ifaces) {
+				t.Errorf("expected %d requests got %d", len(tc.ifaces), len(conn.serials))
+			}
+			if _, err = c.bufConn.ReadByte(); !errors.Is(err, io.EOF) {
+				t.Errorf("conn has unread bytes")
+			}
+		})
+	}
+}
+
+// failWriteConn is a replayConn which fails to write the n-th message,
+// e.g., to imitate an encoding error in the middle of a pipelined batch.
+type failWriteConn struct {
+	replayConn
+	n int
+}
+
+func (c *failWriteConn) Write(b []byte) (int, error) {
+	if len(b) >= msgPrologueSize && b[0] == littleEndian {
+		if c.n--; c.n == 0 {
+			return 0, errors.New("write failed")
+		}
+	}
+	return c.replayConn.Write(b)
+}
+
+func TestClientPipelinedEncodeError(t *testing.T) {
+	unitProps := fakeStringProperties("Id", "dbus.service")
+
+	tt := map[string]struct {
+		// failAt is the message which fails to be written.
+		failAt  int
+		replies []io.Reader
+		call    func(c *Client) error
+		errMsg  string
+	}{
+		// The reply to the first interface is still on the wire
+		// when the second interface fails.
+		"MultiGetAll": {
+			failAt: 2,
+			replies: []io.Reader{
+				bytes.NewReader(encodeFakeReply(10, 1, "", unitProps)),
+			},
+			call: func(c *Client) error {
+				_, err := c.MultiGetAll("/org/freedesktop/systemd1/unit/dbus_2eservice", []string{
+					"org.freedesktop.systemd1.Unit",
+					"org.freedesktop.systemd1.Service",
+				})
+				return err
+			},
+			errMsg: "encode GetAll: write message: write failed",
+		},
+		// ListUnitsByPatterns is followed by GetAll of both units.
+		"ListUnitsWithProperties": {
+			failAt: 3,
+			replies: []io.Reader{
+				bytes.NewReader(listServicesResponse),
+				bytes.NewReader(encodeFakeReply(10, 2, "", unitProps)),
+			},
+			call: func(c *Client) error {
+				return c.ListUnitsWithProperties([]string{"*.service"}, nil, func(*Unit, map[string]Variant) {
+					t.Error("unexpected unit")
+				})
+			},
+			errMsg: "encode GetAll: write message: write failed",
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			replies := append(tc.replies, bytes.NewReader(mainPIDResponse))
+			conn := &failWriteConn{
+				replayConn: replayConn{replies: io.MultiReader(replies...)},
+				n:          tc.failAt,
+			}
+			c := newClient(nil)
+			c.conn = conn
+			c.bufConn.Reset(conn)
+
+			err := tc.call(c)
+			if err == nil || err.Error() != tc.errMsg {
+				t.Fatalf("expected error %q got %v", tc.errMsg, err)
+			}
+
+			// The next call gets its own reply, not the stale one.
+			pid, err := c.MainPID("dbus")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pid != 2375 {
+				t.Errorf("expected pid 2375 got %d", pid)
+			}
+			if _, err = c.bufConn.ReadByte(); !errors.Is(err, io.EOF) {
+				t.Errorf("conn has unread bytes")
+			}
+		})
+	}
+}
+
+func TestClientGetUnitProcessesFallback(t *testing.T) {
+	cgRoot, pRoot := fakeCgroupFS(t, false)
+	defer func(cg, p string) {
+		cgroupRoot, procRoot = cg, p
+	}(cgroupRoot, procRoot)
+	cgroupRoot, procRoot = cgRoot, pRoot
+
+	tt := map[string]struct {
+		opts    []Option
+		replies []io.Reader
+		want    []UnitProcess
+		wantErr error
+	}{
+		"fallback": {
+			opts: []Option{WithCgroupFallback()},
+			replies: []io.Reader{
+				bytes.NewReader(getUnitProcessesUnknownMethodResponse),
+				bytes.NewReader(controlGroupResponse),
+			},
+			want: []UnitProcess{
+				{CgroupPath: "/system.slice/dbus.service", PID: 1241, Command: "/usr/bin/dbus-daemon --system"},
+				{CgroupPath: "/system.slice/dbus.service/helper", PID: 1250, Command: "[kworker/0:1]"},
+			},
+		},
+		"fallback disabled": {
+			replies: []io.Reader{
+				bytes.NewReader(getUnitProcessesUnknownMethodResponse),
+			},
+			wantErr: ErrUnknownMethod,
+		},
+		"method exists": {
+			opts: []Option{WithCgroupFallback()},
+			replies: []io.Reader{
+				bytes.NewReader(getUnitProcessesEmptyResponse),
+			},
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conn := &replayConn{replies: io.MultiReader(tc.replies...)}
+			c := newClient(tc.opts)
+			c.conn = conn
+			c.bufConn.Reset(conn)
+
+			got, err := c.GetUnitProcesses("dbus.service")
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error %v got %v", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error(diff)
+			}
+			if _, err = c.bufConn.ReadByte(); !errors.Is(err, io.EOF) {
+				t.Errorf("conn has unread bytes")
+			}
+		})
+	}
+}
+
+func TestClientInvalidObjectPath(t *testing.T) {
+	conn := &replayConn{replies: strings.NewReader("")}
+	c := newClient(nil)
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	// The message isn't sent, otherwise the bus would disconnect the Client.
+	_, err := c.GetAllProperties("dbus.service", "org.freedesktop.systemd1.Unit")
+	want := `object path must begin with a slash: "dbus.service"`
+	if err == nil || err.Error() != want {
+		t.Fatalf("expected error %q got %q", want, err)
+	}
+	if len(conn.serials) != 0 {
+		t.Errorf("expected no messages got %d", len(conn.serials))
+	}
+}
+
+func TestClientUnits(t *testing.T) {
+	tt := map[string]struct {
+		opts    []Option
+		wantCap int
+	}{
+		"no hint": {},
+		// The slice doesn't grow beyond the hint.
+		"hint": {
+			opts:    []Option{WithUnitsCapacityHint(400)},
+			wantCap: 400,
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conn := &replayConn{replies: bytes.NewReader(listUnitsResponse)}
+			c := newClient(tc.opts)
+			c.conn = conn
+			c.bufConn.Reset(conn)
+
+			units, err := c.Units()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(units) != 156 {
+				t.Errorf("expected 156 units got %d", len(units))
+			}
+			if tc.wantCap != 0 && cap(units) != tc.wantCap {
+				t.Errorf("expected capacity %d got %d", tc.wantCap, cap(units))
+			}
+		})
+	}
+}
+
+func TestClientAppendUnits(t *testing.T) {
+	other := Unit{
+		Name:        "zzz.service",
+		Description: "ZZZ",
+		LoadState:   "loaded",
+		ActiveState: "failed",
+		SubState:    "failed",
+		Path:        "/org/freedesktop/systemd1/unit/zzz_2eservice",
+	}
+	conn := &replayConn{replies: io.MultiReader(
+		bytes.NewReader(listUnitsResponse[:35794]),
+		bytes.NewReader(encodeFakeReply(1, 2, ":1.1", fakeListUnits(other, other))),
+	)}
+	// The units must not change when the buffers are reused by the next call.
+	c := newClient([]Option{WithUnitBufferReuse()})
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	services := make([]Unit, 0, len(expectedServices))
+	services, err := c.AppendUnits(services, IsService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	units := []Unit{other}
+	units, err = c.AppendUnits(units[:1], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expectedServices, services); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff([]Unit{other, other, other}, units); diff != "" {
+		t.Error(diff)
+	}
+
+	// The slice is returned as is when the call fails.
+	units, err = c.AppendUnits(units[:1], nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if len(units) != 1 {
+		t.Errorf("expected 1 unit got %d", len(units))
+	}
+}
+
+func TestClientListUnitsDigest(t *testing.T) {
+	a := Unit{Name: "a.service", ActiveState: "active", SubState: "running", Path: "/org/freedesktop/systemd1/unit/a_2eservice"}
+	b := Unit{Name: "b.service", ActiveState: "inactive", SubState: "dead", Path: "/org/freedesktop/systemd1/unit/b_2eservice"}
+	stopped := a
+	stopped.ActiveState = "inactive"
+	stopped.SubState = "dead"
+	described := a
+	described.Description = "A"
+
+	// The digest is FNV-1a hash of the null-terminated fields.
+	h := fnv.New64a()
+	for _, u := range []Unit{a, b} {
+		h.Write([]byte(u.Name + "\x00" + u.ActiveState + "\x00" + u.SubState + "\x00"))
+	}
+	want := h.Sum64()
+
+	bus := newFakeBus(t)
+	c, err := New(WithAddress(bus.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	digest := func(units ...Unit) uint64 {
+		bus.Reply("ListUnits", fakeListUnits(units...))
+		got, d, err := c.ListUnitsDigest(IsService)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(units, got); diff != "" {
+			t.Error(diff)
+		}
+		return d
+	}
+
+	d := digest(a, b)
+	if d != want {
+		t.Errorf("expected digest %x got %x", want, d)
+	}
+	if got := digest(a, b); got != d {
+		t.Errorf("expected the same digest %x got %x", d, got)
+	}
+	if got := digest(described, b); got != d {
+		t.Errorf("expected description to be ignored, got %x", got)
+	}
+	if got := digest(stopped, b); got == d {
+		t.Error("expected the digest to change when the unit stopped")
+	}
+	if got := digest(b, a); got == d {
+		t.Error("expected the digest to depend on the order")
+	}
+}
+
+func TestClientInspect(t *testing.T) {
+	b := newFakeBus(t)
+	b.Handle("GetUnit", func(call fakeCall) fakeReply {
+		switch call.Args[0] {
+		case "dbus.service", "dbus-org.freedesktop.DBus.service":
+			return fakeObjectPath("/org/freedesktop/systemd1/unit/dbus_2eservice")
+		case "gone.service":
+			return fakeObjectPath("/org/freedesktop/systemd1/unit/gone_2eservice")
+		default:
+			return fakeError("org.freedesktop.systemd1.NoSuchUnit", "Unit "+call.Args[0]+" not loaded.")
+		}
+	})
+	b.Handle("GetAll", func(call fakeCall) fakeReply {
+		if call.Path == "/org/freedesktop/systemd1/unit/gone_2eservice" {
+			return fakeStringProperties(
+				"Id", "gone.service",
+				"LoadState", "not-found",
+				"ActiveState", "inactive",
+				"SubState", "dead",
+			)
+		}
+		return fakeStringProperties(
+			"Id", "dbus.service",
+			"Description", "D-Bus System Message Bus",
+			"LoadState", "loaded",
+			"ActiveState", "active",
+			"SubState", "running",
+			"Following", "",
+		)
+	})
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	want := &Unit{
+		Name:        "dbus.service",
+		Description: "D-Bus System Message Bus",
+		LoadState:   "loaded",
+		ActiveState: "active",
+		SubState:    "running",
+		Path:        "/org/freedesktop/systemd1/unit/dbus_2eservice",
+	}
+	// The alias resolves to the same unit.
+	for _, name := range []string{"dbus.service", "dbus-org.freedesktop.DBus.service"} {
+		got, err := c.Inspect(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Error(diff)
+		}
+	}
+
+	for _, name := range []string{"nonexistent.service", "gone.service"} {
+		if _, err = c.Inspect(name); !errors.Is(err, ErrNoSuchUnit) {
+			t.Errorf("%s: expected ErrNoSuchUnit got %v", name, err)
+		}
+	}
+}
+
+func TestClientFindUnit(t *testing.T) {
+	// The captured ListUnits reply is followed by zero padding
+	// which is cut off, so the replies are read back to back.
+	conn := &replayConn{replies: io.MultiReader(
+		bytes.NewReader(listUnitsResponse[:35794]),
+		bytes.NewReader(listUnitsResponse[:35794]),
+	)}
+	c := newClient(nil)
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	got, err := c.FindUnit(IsService)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&expectedServices[0], got); diff != "" {
+		t.Error(diff)
+	}
+
+	got, err = c.FindUnit(func(fieldIndex int, s []byte) bool {
+		return fieldIndex != 0
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Errorf("expected no unit got %+v", got)
+	}
+}
+
+func BenchmarkClientListUnits(b *testing.B) {
+	tt := map[string][]Option{
+		"new buffers":    nil,
+		"reused buffers": {WithUnitBufferReuse()},
+	}
+
+	for name, opts := range tt {
+		b.Run(name, func(b *testing.B) {
+			// The recorded listUnitsResponse has zero bytes past the message.
+			replies := bytes.NewReader(listUnitsResponse[:35794])
+			conn := &replayConn{replies: replies}
+			c := newClient(opts)
+			c.conn = conn
+			c.bufConn.Reset(conn)
+
+			var n int
+			f := func(u *Unit) { n++ }
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				replies.Seek(0, io.SeekStart)
+				conn.serials = conn.serials[:0]
+				n = 0
+
+				if err := c.ListUnits(nil, f); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			if n != 156 {
+				b.Errorf("expected 156 units got %d", n)
+			}
+		})
+	}
+}
+
+func TestClientNFailedUnits(t *testing.T) {
+	conn := &replayConn{replies: bytes.NewReader(nFailedUnitsResponse)}
+	c := newClient(nil)
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	got, err := c.NFailedUnits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want uint32 = 1
+	if want != got {
+		t.Errorf("expected %d got %d", want, got)
+	}
+}
+
+func TestClientUnitFileReport(t *testing.T) {
+	conn := &replayConn{replies: bytes.NewReader(listUnitFilesResponse)}
+	c := newClient(nil)
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	got, err := c.UnitFileReport()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The unit files are sorted by path.
+	want := []UnitFile{
+		{Path: "/etc/systemd/system/getty.target.wants/getty@tty1.service", State: "enabled"},
+		{Path: "/lib/systemd/system/apt-daily.timer", State: "disabled"},
+		{Path: "/lib/systemd/system/dbus.service", State: "static"},
+		{Path: "/lib/systemd/system/ssh.service", State: "enabled"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestClientHealth(t *testing.T) {
+	replies := io.MultiReader(
+		bytes.NewReader(pingResponse),
+		bytes.NewReader(nFailedUnitsResponse),
+	)
+	conn := &replayConn{replies: replies}
+	c := newClient(nil)
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := c.Health(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// Ping and then NFailedUnits.
+	if diff := cmp.Diff([]uint32{1, 2}, conn.serials); diff != "" {
+		t.Error(diff)
+	}
+
+	// Nothing is sent when the context is already canceled.
+	cancel()
+	if err := c.Health(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled got %v", err)
+	}
+	if len(conn.serials) != 2 {
+		t.Errorf("expected no calls got serials %v", conn.serials)
+	}
+}
+
+func TestClientHealthDeadline(t *testing.T) {
+	// The bus replies to Ping, but NFailedUnits reply never comes.
+	addr, _ := helloBus(t)
+	c, err := New(WithAddress(addr), WithTimeout(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = c.Health(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Health took too long: %s", elapsed)
+	}
+}
+
+func TestClientListUnitsWithProperties(t *testing.T) {
+	type unitProps struct {
+		Name  string
+		Props map[string]Variant
+	}
+	dbus := unitProps{
+		Name: "dbus.service",
+		Props: map[string]Variant{
+			"MemoryCurrent": {Signature: "t", U: 4423680},
+			"NRestarts":     {Signature: "u", U: 0},
+		},
+	}
+	ssh := unitProps{
+		Name: "ssh.service",
+		Props: map[string]Variant{
+			"MemoryCurrent": {Signature: "t", U: 2400256},
+			"NRestarts":     {Signature: "u", U: 1},
+		},
+	}
+
+	tt := map[string]struct {
+		replies []io.Reader
+		want    []unitProps
+		wantErr string
+	}{
+		// The replies come in the reverse order,
+		// so they are matched to the units by the serials.
+		"reordered replies": {
+			replies: []io.Reader{
+				bytes.NewReader(listServicesResponse),
+				bytes.NewReader(sshPropertiesResponse),
+				bytes.NewReader(dbusPropertiesResponse),
+			},
+			want: []unitProps{ssh, dbus},
+		},
+		"unit error": {
+			replies: []io.Reader{
+				bytes.NewReader(listServicesResponse),
+				bytes.NewReader(sshUnknownObjectResponse),
+				bytes.NewReader(dbusPropertiesResponse),
+			},
+			want:    []unitProps{dbus},
+			wantErr: "decode GetAll ssh.service: Unknown object '/org/freedesktop/systemd1/unit/ssh_2eservice'.",
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conn := &replayConn{replies: io.MultiReader(tc.replies...)}
+			c := newClient(nil)
+			c.conn = conn
+			c.bufConn.Reset(conn)
+
+			var got []unitProps
+			err := c.ListUnitsWithProperties([]string{"*.service"}, []string{"MemoryCurrent", "NRestarts"}, func(u *Unit, props map[string]Variant) {
+				got = append(got, unitProps{Name: u.Name, Props: props})
+			})
+			if tc.wantErr == "" && err != nil {
+				t.Fatal(err)
+			}
+			if tc.wantErr != "" && (err == nil || err.Error() != tc.wantErr) {
+				t.Fatalf("expected error %q got %q", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error(diff)
+			}
+
+			// ListUnitsByPatterns and then GetAll for each unit.
+			if diff := cmp.Diff([]uint32{1, 2, 3}, conn.serials); diff != "" {
+				t.Error(diff)
+			}
+			// The header fields are skipped again.
+			if !c.msgDec.SkipHeaderFields {
+				t.Error("expected header fields to be skipped")
+			}
+			if _, err = c.bufConn.ReadByte(); !errors.Is(err, io.EOF) {
+				t.Errorf("conn has unread bytes")
+			}
+		})
+	}
+}
+
+func TestClientListUnitsByPatternsBatch(t *testing.T) {
+	patterns := []string{"dbus.service", "ssh*", "*.service"}
+	tt := map[string]struct {
+		opts        []Option
+		wantSerials []uint32
+	}{
+		"no batching": {
+			wantSerials: []uint32{1},
+		},
+		"batch larger than patterns": {
+			opts:        []Option{WithListBatchSize(5)},
+			wantSerials: []uint32{1},
+		},
+		"batch of two": {
+			opts:        []Option{WithListBatchSize(2)},
+			wantSerials: []uint32{1, 2},
+		},
+		"batch of one": {
+			opts:        []Option{WithListBatchSize(1), WithUnitBufferReuse()},
+			wantSerials: []uint32{1, 2, 3},
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			// Every batch matches both units
+			// which are reported once.
+			var replies []io.Reader
+			for range tc.wantSerials {
+				replies = append(replies, bytes.NewReader(listServicesResponse))
+			}
+			conn := &replayConn{replies: io.MultiReader(replies...)}
+			c := newClient(tc.opts)
+			c.conn = conn
+			c.bufConn.Reset(conn)
+
+			var got []string
+			err := c.ListUnitsByPatterns(nil, patterns, nil, func(u *Unit) {
+				got = append(got, strings.Clone(u.Name))
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := []string{"dbus.service", "ssh.service"}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(tc.wantSerials, conn.serials); diff != "" {
+				t.Error(diff)
+			}
+			if _, err = c.bufConn.ReadByte(); !errors.Is(err, io.EOF) {
+				t.Errorf("conn has unread bytes")
+			}
+		})
+	}
+}
+
+// listServicesResponse is a reply to ListUnitsByPatterns method
+// with dbus.service and ssh.service units.
+var listServicesResponse = []byte{108, 2, 1, 1, 82, 1, 0, 0, 252, 8, 0, 0, 61, 0, 0, 0, 5, 1, 117, 0, 1, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 13, 97, 40, 115, 115, 115, 115, 115, 115, 111, 117, 115, 111, 41, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 74, 1, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 24, 0, 0, 0, 68, 45, 66, 117, 115, 32, 83, 121, 115, 116, 101, 109, 32, 77, 101, 115, 115, 97, 103, 101, 32, 66, 117, 115, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 114, 117, 110, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 11, 0, 0, 0, 115, 115, 104, 46, 115, 101, 114, 118, 105, 99, 101, 0, 27, 0, 0, 0, 79, 112, 101, 110, 66, 83, 68, 32, 83, 101, 99, 117, 114, 101, 32, 83, 104, 101, 108, 108, 32, 115, 101, 114, 118, 101, 114, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 114, 117, 110, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 44, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 115, 104, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0}
+
+// sshPropertiesResponse is a reply to GetAll method with the empty interface name
+// for ssh.service unit (the request serial 3).
+var sshPropertiesResponse = []byte{108, 2, 1, 1, 128, 0, 0, 0, 253, 8, 0, 0, 53, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 5, 97, 123, 115, 118, 125, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 120, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 73, 100, 0, 1, 115, 0, 0, 0, 11, 0, 0, 0, 115, 115, 104, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 0, 11, 0, 0, 0, 65, 99, 116, 105, 118, 101, 83, 116, 97, 116, 101, 0, 1, 115, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 9, 0, 0, 0, 78, 82, 101, 115, 116, 97, 114, 116, 115, 0, 1, 117, 0, 0, 0, 0, 1, 0, 0, 0, 13, 0, 0, 0, 77, 101, 109, 111, 114, 121, 67, 117, 114, 114, 101, 110, 116, 0, 1, 116, 0, 0, 0, 0, 0, 160, 36, 0, 0, 0, 0, 0}
+
+// dbusPropertiesResponse is a reply to GetAll method with the empty interface name
+// for dbus.service unit (the request serial 2).
+var dbusPropertiesResponse = []byte{108, 2, 1, 1, 128, 0, 0, 0, 254, 8, 0, 0, 53, 0, 0, 0, 5, 1, 117, 0, 2, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 5, 97, 123, 115, 118, 125, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 120, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 73, 100, 0, 1, 115, 0, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 11, 0, 0, 0, 65, 99, 116, 105, 118, 101, 83, 116, 97, 116, 101, 0, 1, 115, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 9, 0, 0, 0, 78, 82, 101, 115, 116, 97, 114, 116, 115, 0, 1, 117, 0, 0, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 77, 101, 109, 111, 114, 121, 67, 117, 114, 114, 101, 110, 116, 0, 1, 116, 0, 0, 0, 0, 0, 128, 67, 0, 0, 0, 0, 0}
+
+// sshUnknownObjectResponse is an error reply to GetAll method
+// for ssh.service unit which was unloaded after it had been listed.
+var sshUnknownObjectResponse = []byte{108, 3, 1, 1, 67, 0, 0, 0, 255, 8, 0, 0, 101, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 4, 1, 115, 0, 40, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 69, 114, 114, 111, 114, 46, 85, 110, 107, 110, 111, 119, 110, 79, 98, 106, 101, 99, 116, 0, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 1, 115, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 62, 0, 0, 0, 85, 110, 107, 110, 111, 119, 110, 32, 111, 98, 106, 101, 99, 116, 32, 39, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 115, 104, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 39, 46, 0}
+
+func TestClientKillUnitSignal(t *testing.T) {
+	b := newFakeBus(t)
+	b.Reply("KillUnit", fakeReply{})
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	tt := map[string]struct {
+		signalName string
+		want       uint32
+		errMsg     string
+	}{
+		"SIGTERM":        {signalName: "SIGTERM", want: 15},
+		"SIGHUP":         {signalName: "SIGHUP", want: 1},
+		"without prefix": {signalName: "KILL", want: 9},
+		"unknown":        {signalName: "SIGFOO", errMsg: `unknown signal: "SIGFOO"`},
+		"lowercase":      {signalName: "sigterm", errMsg: `unknown signal: "sigterm"`},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			calls := len(b.Calls())
+			err := c.KillUnitSignal("dbus.service", "main", tc.signalName)
+			if tc.errMsg != "" {
+				if err == nil || err.Error() != tc.errMsg {
+					t.Fatalf("expected error %q got %v", tc.errMsg, err)
+				}
+				if len(b.Calls()) != calls {
+					t.Error("expected no KillUnit call")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := b.Calls()
+			call := got[len(got)-1]
+			if call.Member != "KillUnit" || call.Signature != "ssi" {
+				t.Fatalf("unexpected call %s(%s)", call.Member, call.Signature)
+			}
+			if diff := cmp.Diff([]string{"dbus.service", "main"}, call.Args); diff != "" {
+				t.Error(diff)
+			}
+
+			d := newDecoder(bytes.NewReader(call.Body))
+			for i := 0; i < 2; i++ {
+				if err = d.SkipString(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			signal, err := d.Uint32()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if signal != tc.want {
+				t.Errorf("expected signal %d got %d", tc.want, signal)
+			}
+		})
+	}
+}
+
+func TestClientSetProperty(t *testing.T) {
+	b := newFakeBus(t)
+	b.Reply("SetUnitProperties", fakeReply{})
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	value := Variant{Signature: "t", U: 500000}
+	if err = c.SetProperty("dbus.service", "CPUQuotaPerSecUSec", value, true); err != nil {
+		t.Fatal(err)
+	}
+
+	got := b.Calls()
+	call := got[len(got)-1]
+	if call.Member != "SetUnitProperties" || call.Signature != "sba(sv)" {
+		t.Fatalf("unexpected call %s(%s)", call.Member, call.Signature)
+	}
+
+	conv := newStringConverter(32)
+	d := newDecoder(bytes.NewReader(call.Body))
+	unit, err := d.String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(unit) != "dbus.service" {
+		t.Errorf("expected unit dbus.service got %s", unit)
+	}
+	runtime, err := d.Uint32()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runtime != 1 {
+		t.Errorf("expected runtime 1 got %d", runtime)
+	}
+	arrLen, err := d.Uint32()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = d.Align(8); err != nil {
+		t.Fatal(err)
+	}
+	arrStart := d.Offset()
+	prop, err := d.String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(prop) != "CPUQuotaPerSecUSec" {
+		t.Errorf("expected property CPUQuotaPerSecUSec got %s", prop)
+	}
+	var v Variant
+	if err = d.Variant(conv, &v); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(value, v); diff != "" {
+		t.Error(diff)
+	}
+	if d.Offset()-arrStart != arrLen {
+		t.Errorf("expected array length %d got %d", d.Offset()-arrStart, arrLen)
+	}
+
+	calls := len(b.Calls())
+	tt := map[string]struct {
+		prop   string
+		value  Variant
+		errMsg string
+	}{
+		"empty name":    {value: value, errMsg: "property name is empty"},
+		"unknown value": {prop: "LogLevelMax", value: Variant{Signature: "(ss)"}, errMsg: `unsupported variant signature: "(ss)"`},
+	}
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			err := c.SetProperty("dbus.service", tc.prop, tc.value, false)
+			if err == nil || err.Error() != tc.errMsg {
+				t.Errorf("expected error %q got %v", tc.errMsg, err)
+			}
+		})
+	}
+	if len(b.Calls()) != calls {
+		t.Error("expected no SetUnitProperties call")
+	}
+}
+
+func TestClientEnvironmentValidation(t *testing.T) {
+	b := newFakeBus(t)
+	b.Reply("SetEnvironment", fakeReply{})
+	b.Reply("UnsetEnvironment", fakeReply{})
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	err = c.SetEnvironment([]string{"FOO=bar", "BAZ", "1X=y", "LANG=C.UTF-8", "=empty"})
+	want := `invalid environment assignments: ["BAZ" "1X=y" "=empty"]`
+	if err == nil || err.Error() != want {
+		t.Fatalf("expected error %q got %v", want, err)
+	}
+	err = c.UnsetEnvironment([]string{"FOO", "BAR=baz", "FOO-BAR"})
+	want = `invalid environment variable names: ["FOO-BAR"]`
+	if err == nil || err.Error() != want {
+		t.Fatalf("expected error %q got %v", want, err)
+	}
+	for _, call := range b.Calls() {
+		if call.Member == "SetEnvironment" || call.Member == "UnsetEnvironment" {
+			t.Fatalf("expected no %s call", call.Member)
+		}
+	}
+
+	if err = c.SetEnvironment([]string{"FOO=bar", "EMPTY="}); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.UnsetEnvironment([]string{"FOO", "EMPTY="}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientControlGroup(t *testing.T) {
+	b := newFakeBus(t)
+	b.Handle("Get", func(call fakeCall) fakeReply {
+		switch call.Path {
+		case "/org/freedesktop/systemd1/unit/dbus_2eservice":
+			return fakeReply{
+				Signature: "v",
+				Body: func(enc *encoder) {
+					enc.Signature("s")
+					enc.String("/system.slice/dbus.service")
+				},
+			}
+		case "/org/freedesktop/systemd1/unit/multi_2duser_2etarget":
+			return fakeError("org.freedesktop.DBus.Error.UnknownProperty", "Unknown property or interface.")
+		default:
+			return fakeError("org.freedesktop.systemd1.NoSuchUnit", "Unit not loaded.")
+		}
+	})
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	tt := map[string]struct {
+		want    string
+		wantErr error
+	}{
+		"dbus.service":       {want: "/system.slice/dbus.service"},
+		"multi-user.target":  {want: ""},
+		"nonexistent.socket": {wantErr: ErrNoSuchUnit},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.ControlGroup(name)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error %v got %v", tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %q got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestClientEnsureEnabled(t *testing.T) {
+	symlink := UnitFileChange{
+		Type:        "symlink",
+		Filename:    "/etc/systemd/system/multi-user.target.wants/ssh.service",
+		Destination: "/lib/systemd/system/ssh.service",
+	}
+	unlink := UnitFileChange{
+		Type:     "unlink",
+		Filename: "/etc/systemd/system/multi-user.target.wants/ssh.service",
+	}
+	installInfo, noInstallInfo := true, false
+
+	tt := map[string]struct {
+		disable     bool
+		state       string
+		reply       fakeReply
+		want        bool
+		wantCalls   []string
+		wantRuntime uint32
+	}{
+		"enable disabled": {
+			state:     "disabled",
+			reply:     fakeUnitFileChanges(&installInfo, symlink),
+			want:      true,
+			wantCalls: []string{"GetUnitFileState", "EnableUnitFiles", "Reload"},
+		},
+		"enable enabled": {
+			state:     "enabled",
+			wantCalls: []string{"GetUnitFileState"},
+		},
+		"enable static": {
+			state:     "static",
+			reply:     fakeUnitFileChanges(&noInstallInfo),
+			wantCalls: []string{"GetUnitFileState", "EnableUnitFiles"},
+		},
+		"disable enabled": {
+			disable:   true,
+			state:     "enabled",
+			reply:     fakeUnitFileChanges(nil, unlink),
+			want:      true,
+			wantCalls: []string{"GetUnitFileState", "DisableUnitFiles", "Reload"},
+		},
+		"disable enabled-runtime": {
+			disable:     true,
+			state:       "enabled-runtime",
+			reply:       fakeUnitFileChanges(nil, unlink),
+			want:        true,
+			wantCalls:   []string{"GetUnitFileState", "DisableUnitFiles", "Reload"},
+			wantRuntime: 1,
+		},
+		"disable disabled": {
+			disable:   true,
+			state:     "disabled",
+			wantCalls: []string{"GetUnitFileState"},
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			b := newFakeBus(t)
+			b.Reply("GetUnitFileState", fakeReply{
+				Signature: "s",
+				Body: func(enc *encoder) {
+					enc.String(tc.state)
+				},
+			})
+			b.Reply("EnableUnitFiles", tc.reply)
+			b.Reply("DisableUnitFiles", tc.reply)
+			b.Reply("Reload", fakeReply{})
+
+			c, err := New(WithAddress(b.Addr))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer c.Close()
+
+			var got bool
+			if tc.disable {
+				got, err = c.EnsureDisabled("ssh.service")
+			} else {
+				got, err = c.EnsureEnabled("ssh.service")
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("expected changed %t got %t", tc.want, got)
+			}
+
+			var (
+				calls   []string
+				runtime uint32
+			)
+			for _, call := range b.Calls() {
+				if call.Member == "Hello" {
+					continue
+				}
+				calls = append(calls, call.Member)
+
+				if call.Member == "EnableUnitFiles" || call.Member == "DisableUnitFiles" {
+					d := newDecoder(bytes.NewReader(call.Body))
+					var files Variant
+					if err = d.Value(newStringConverter(32), "as", &files); err != nil {
+						t.Fatal(err)
+					}
+					if diff := cmp.Diff([]string{"ssh.service"}, files.Strings); diff != "" {
+						t.Error(diff)
+					}
+					if runtime, err = d.Uint32(); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+			if diff := cmp.Diff(tc.wantCalls, calls); diff != "" {
+				t.Error(diff)
+			}
+			if runtime != tc.wantRuntime {
+				t.Errorf("expected runtime %d got %d", tc.wantRuntime, runtime)
+			}
+		})
+	}
+}
+
+func TestClientFreezerState(t *testing.T) {
+	b := newFakeBus(t)
+	b.Handle("Get", func(call fakeCall) fakeReply {
+		if call.Args[0] != "org.freedesktop.systemd1.Unit" || call.Args[1] != "FreezerState" {
+			return fakeError("org.freedesktop.DBus.Error.InvalidArgs", "Invalid arguments.")
+		}
+		switch call.Path {
+		case "/org/freedesktop/systemd1/unit/dbus_2eservice":
+			return fakeReply{
+				Signature: "v",
+				Body: func(enc *encoder) {
+					enc.Signature("s")
+					enc.String("frozen")
+				},
+			}
+		case "/org/freedesktop/systemd1/unit/cron_2eservice":
+			// systemd older than v246.
+			return fakeError("org.freedesktop.DBus.Error.UnknownProperty", "Unknown property or interface.")
+		default:
+			return fakeError("org.freedesktop.systemd1.NoSuchUnit", "Unit not loaded.")
+		}
+	})
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	tt := map[string]struct {
+		want    string
+		wantErr error
+	}{
+		"dbus.service":       {want: "frozen"},
+		"cron.service":       {wantErr: ErrUnknownProperty},
+		"nonexistent.socket": {wantErr: ErrNoSuchUnit},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.FreezerState(name)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error %v got %v", tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %q got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestClientListUnitsInSlice(t *testing.T) {
+	units := []Unit{
+		{Name: "dbus.service", Path: "/org/freedesktop/systemd1/unit/dbus_2eservice"},
+		{Name: "machine-qemu.scope", Path: "/org/freedesktop/systemd1/unit/machine_2dqemu_2escope"},
+		{Name: "libvirtd.service", Path: "/org/freedesktop/systemd1/unit/libvirtd_2eservice"},
+	}
+	slices := map[string]string{
+		units[0].Path: "system.slice",
+		units[1].Path: "machine.slice",
+		units[2].Path: "system.slice",
+	}
+
+	b := newFakeBus(t)
+	b.Reply("ListUnitsByPatterns", fakeListUnits(units...))
+	b.Handle("GetAll", func(call fakeCall) fakeReply {
+		return fakeStringProperties("Id", "x", "Slice", slices[call.Path])
+	})
+	b.Handle("Get", func(call fakeCall) fakeReply {
+		return fakeReply{
+			Signature: "v",
+			Body: func(enc *encoder) {
+				enc.Signature("s")
+				enc.String(slices[call.Path])
+			},
+		}
+	})
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	var got []string
+	err = c.ListUnitsInSlice("system.slice", func(u *Unit) {
+		got = append(got, u.Name)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"dbus.service", "libvirtd.service"}, got); diff != "" {
+		t.Error(diff)
+	}
+
+	slice, err := c.SliceOf("machine-qemu.scope")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slice != "machine.slice" {
+		t.Errorf("expected machine.slice got %q", slice)
+	}
+}
+
+func TestClientListUnitsInSliceBatchReuse(t *testing.T) {
+	services := []Unit{
+		{Name: "dbus.service", Path: "/org/freedesktop/systemd1/unit/dbus_2eservice"},
+		{Name: "libvirtd.service", Path: "/org/freedesktop/systemd1/unit/libvirtd_2eservice"},
+	}
+	scopes := []Unit{
+		{Name: "machine-qemu.scope", Path: "/org/freedesktop/systemd1/unit/machine_2dqemu_2escope"},
+	}
+	slices := map[string]string{
+		services[0].Path: "system.slice",
+		services[1].Path: "system.slice",
+		scopes[0].Path:   "machine.slice",
+	}
+
+	// Each pattern is listed in its own batch,
+	// i.e., *.service and then *.scope.
+	b := newFakeBus(t)
+	var batch int
+	b.Handle("ListUnitsByPatterns", func(call fakeCall) fakeReply {
+		batch++
+		if batch == 1 {
+			return fakeListUnits(services...)
+		}
+		return fakeListUnits(scopes...)
+	})
+	b.Handle("GetAll", func(call fakeCall) fakeReply {
+		if _, ok := slices[call.Path]; !ok {
+			return fakeError("org.freedesktop.DBus.Error.UnknownObject", "Unknown object '"+call.Path+"'.")
+		}
+		return fakeStringProperties("Slice", slices[call.Path])
+	})
+
+	c, err := New(WithAddress(b.Addr), WithListBatchSize(1), WithUnitBufferReuse())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	var got []string
+	err = c.ListUnitsInSlice("system.slice", func(u *Unit) {
+		got = append(got, u.Name)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"dbus.service", "libvirtd.service"}, got); diff != "" {
+		t.Error(diff)
+	}
+	if batch != 2 {
+		t.Errorf("expected 2 batches got %d", batch)
+	}
+}
+
+func TestClientCall(t *testing.T) {
+	const sessionPath = "/org/freedesktop/login1/session/_32"
+	b := newFakeBus(t)
+	b.Reply("GetSession", fakeObjectPath(sessionPath))
+	b.Reply("LockSessions", fakeReply{})
+	b.Reply("GetSessionInfo", fakeReply{
+		Signature: "su",
+		Body: func(enc *encoder) {
+			enc.String("alice")
+			enc.Uint32(1000)
+		},
+	})
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	const (
+		dest  = "org.freedesktop.login1"
+		path  = "/org/freedesktop/login1"
+		iface = "org.freedesktop.login1.Manager"
+	)
+	tt := map[string]struct {
+		member string
+		args   []Variant
+		want   []Variant
+	}{
+		"object path reply": {
+			member: "GetSession",
+			args:   []Variant{{Signature: "s", S: "2"}},
+			want:   []Variant{{Signature: "o", S: sessionPath}},
+		},
+		"empty reply": {
+			member: "LockSessions",
+		},
+		"many values": {
+			member: "GetSessionInfo",
+			args: []Variant{
+				{Signature: "s", S: "2"},
+				{Signature: "b", U: 1},
+			},
+			want: []Variant{
+				{Signature: "s", S: "alice"},
+				{Signature: "u", U: 1000},
+			},
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.Call(dest, path, iface, tc.member, tc.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error(diff)
+			}
+
+			calls := b.Calls()
+			call := calls[len(calls)-1]
+			if call.Destination != dest || call.Path != path || call.Interface != iface || call.Member != tc.member {
+				t.Errorf("unexpected call %+v", call)
+			}
+		})
+	}
+
+	// The arguments' signatures make up the body signature.
+	calls := b.Calls()
+	for _, call := range calls {
+		if call.Member != "GetSessionInfo" {
+			continue
+		}
+		if call.Signature != "sb" {
+			t.Errorf("expected signature sb got %q", call.Signature)
+		}
+		if diff := cmp.Diff([]string{"2"}, call.Args); diff != "" {
+			t.Error(diff)
+		}
+	}
+}
+
+func TestClientCallInvalid(t *testing.T) {
+	b := newFakeBus(t)
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	tt := map[string]struct {
+		dest, path, iface, member string
+		args                      []Variant
+		errMsg                    string
+	}{
+		"bus name": {
+			dest:   "login1",
+			path:   "/org/freedesktop/login1",
+			iface:  "org.freedesktop.login1.Manager",
+			member: "ListSessions",
+			errMsg: `bus name must have at least two elements: "login1"`,
+		},
+		"object path": {
+			dest:   "org.freedesktop.login1",
+			path:   "org/freedesktop/login1",
+			iface:  "org.freedesktop.login1.Manager",
+			member: "ListSessions",
+			errMsg: `object path must begin with a slash: "org/freedesktop/login1"`,
+		},
+		"interface": {
+			dest:   "org.freedesktop.login1",
+			path:   "/org/freedesktop/login1",
+			iface:  "org.freedesktop.login1.Manager-2",
+			member: "ListSessions",
+			errMsg: `interface name has an invalid character '-': "org.freedesktop.login1.Manager-2"`,
+		},
+		"member": {
+			dest:   "org.freedesktop.login1",
+			path:   "/org/freedesktop/login1",
+			iface:  "org.freedesktop.login1.Manager",
+			member: "List.Sessions",
+			errMsg: `member name has an invalid character '.': "List.Sessions"`,
+		},
+		"argument": {
+			dest:   "org.freedesktop.login1",
+			path:   "/org/freedesktop/login1",
+			iface:  "org.freedesktop.login1.Manager",
+			member: "ListSessions",
+			args:   []Variant{{Signature: "a(so)"}},
+			errMsg: `argument 0: unsupported variant signature: "a(so)"`,
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			_, err := c.Call(tc.dest, tc.path, tc.iface, tc.member, tc.args)
+			if err == nil || err.Error() != tc.errMsg {
+				t.Fatalf("expected error %q got %q", tc.errMsg, err)
+			}
+		})
+	}
+
+	// Nothing but Hello was sent to the bus.
+	if calls := b.Calls(); len(calls) != 1 {
+		t.Errorf("expected 1 call got %d", len(calls))
+	}
+}
+
+func TestClientReloadOrRestartUnit(t *testing.T) {
+	const jobPath = "/org/freedesktop/systemd1/job/1292"
+	b := newFakeBus(t)
+	b.Reply("ReloadOrRestartUnit", fakeObjectPath(jobPath))
+	b.Reply("ReloadOrTryRestartUnit", fakeObjectPath(jobPath))
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	tt := map[string]func(name, mode string, opts ...CallOption) (string, error){
+		"ReloadOrRestartUnit":    c.ReloadOrRestartUnit,
+		"ReloadOrTryRestartUnit": c.ReloadOrTryRestartUnit,
+	}
+
+	for member, f := range tt {
+		t.Run(member, func(t *testing.T) {
+			got, err := f("dbus.service", "replace")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != jobPath {
+				t.Errorf("expected %q got %q", jobPath, got)
+			}
+
+			calls := b.Calls()
+			call := calls[len(calls)-1]
+			if call.Member != member || call.Signature != "ss" {
+				t.Errorf("unexpected call %s(%s)", call.Member, call.Signature)
+			}
+			if diff := cmp.Diff([]string{"dbus.service", "replace"}, call.Args); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
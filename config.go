@@ -1,6 +1,9 @@
 package systemd
 
 import (
+	"context"
+	"net"
+	"sync"
 	"time"
 )
 
@@ -15,6 +18,10 @@ const (
 	// using 4KB buffer.
 	// It takes over 4K syscalls without buffering to decode the same message.
 	DefaultConnectionReadSize = 4096
+	// DefaultMaxConnectionReadSize is the default limit (in bytes)
+	// of the read buffer which grows to fit a large reply,
+	// see WithMaxConnectionReadSize.
+	DefaultMaxConnectionReadSize = 1 << 20
 	// DefaultStringConverterSize is the default buffer size (in bytes)
 	// of the string converter that is used to convert bytes to strings
 	// with less allocs.
@@ -23,6 +30,10 @@ const (
 	// a 4KB buffer showed 24.96 KB/op and 7 allocs/op
 	// in a benchmark when decoding 35KB message.
 	DefaultStringConverterSize = 4096
+	// DefaultMaxArrayElements is the default limit
+	// of the elements decoded from an array, see WithMaxArrayElements.
+	// It's generous enough for a host with hundreds of thousands of units.
+	DefaultMaxArrayElements = 1 << 17
 )
 
 // Config represents a Client config.
@@ -35,16 +46,69 @@ type Config struct {
 	// connReadSize defines the length of a buffer to read from
 	// a D-Bus connection.
 	connReadSize int
+	// maxConnReadSize limits the length of a buffer to read
+	// a large reply from a D-Bus connection.
+	// Zero means the buffer doesn't grow.
+	maxConnReadSize int
 	// strConvSize defines the length of a buffer of a string converter.
 	strConvSize int
+	// maxReadBufSize limits the length of a buffer
+	// where the decoder reads the message values.
+	// Zero means there is no limit.
+	maxReadBufSize int
+	// maxArrayElems limits the number of elements decoded from an array.
+	// Zero means there is no limit.
+	maxArrayElems int
 	// isSerialCheckEnabled when set will check whether message serials match.
 	isSerialCheckEnabled bool
+	// isStrictDecodingEnabled when set will validate the received messages.
+	isStrictDecodingEnabled bool
+	// isUnixFDEnabled when set will negotiate Unix file descriptor passing
+	// during authentication.
+	isUnixFDEnabled bool
+	// keepAliveInterval is how often the idle connection is pinged.
+	// Zero means the connection is not pinged.
+	keepAliveInterval time.Duration
+	// streamEncSize is the size of chunks in which a message is written
+	// into the connection while it's being encoded.
+	// Zero means the whole message is buffered before writing.
+	streamEncSize int
+	// initialSerial is the serial of Hello message
+	// which is the first message sent over a new connection.
+	initialSerial uint32
+	// isHelloDisabled when set will skip Hello message after auth,
+	// e.g., when the peer isn't a message bus.
+	isHelloDisabled bool
+	// isCgroupFallbackEnabled when set will read the unit's processes
+	// from the cgroup file system if systemd lacks GetUnitProcesses method.
+	isCgroupFallbackEnabled bool
+	// unitsCapHint is the initial capacity of the slice returned by Units.
+	unitsCapHint int
+	// isUnitBufferReuseEnabled when set will reuse the string converter
+	// buffers of the unit fields across ListUnits calls.
+	isUnitBufferReuseEnabled bool
+	// authMechs are the auth mechanisms the Client tries in order.
+	authMechs []string
+	// authUID is the Unix uid the Client authenticates as.
+	// Negative uid means the effective uid of the process.
+	authUID int
+	// listBatchSize is the maximum number of patterns
+	// sent in a single ListUnitsByPatterns call.
+	// Zero means all the patterns are sent at once.
+	listBatchSize int
+	// connFactory obtains a new connection to the message bus
+	// instead of dialing busAddr if it's set.
+	connFactory func(ctx context.Context) (net.Conn, error)
+	// bufPool lends the decoder's read buffer for the duration of a call.
+	// The decoder keeps its own buffer if it's nil.
+	bufPool *sync.Pool
 }
 
 // Option sets up a Config.
 type Option func(*Config)
 
 // WithAddress sets a bus address.
+// For example, use SessionBusAddress to connect to the user's message bus.
 func WithAddress(addr string) Option {
 	return func(c *Config) {
 		c.busAddr = addr
@@ -68,6 +132,19 @@ func WithConnectionReadSize(size int) Option {
 	}
 }
 
+// WithMaxConnectionReadSize sets a limit of the buffer
+// which is used for reading a large reply from a D-Bus connection.
+// When a reply is many times larger than the buffer set by WithConnectionReadSize,
+// the reply is read with a bigger buffer up to that limit,
+// e.g., ListUnits makes 3 read syscalls instead of 262 when decoding 1MB message.
+// The bigger buffer is kept by the Client to be reused by the following large replies.
+// Zero disables the buffer growth.
+func WithMaxConnectionReadSize(size int) Option {
+	return func(c *Config) {
+		c.maxConnReadSize = size
+	}
+}
+
 // WithStringConverterSize sets a buffer size of the string converter
 // to reduce allocs.
 func WithStringConverterSize(size int) Option {
@@ -76,9 +153,72 @@ func WithStringConverterSize(size int) Option {
 	}
 }
 
+// WithMaxReadBuffer limits a size of a buffer
+// where the message values are read into when decoding,
+// e.g., a peer can't make the Client allocate hundreds of megabytes
+// by declaring an oversized message.
+// The bytes which don't need decoding such as a signal body
+// are discarded in chunks of that size.
+// Strings longer than the limit result in an error.
+// By default the buffer grows as needed.
+func WithMaxReadBuffer(size int) Option {
+	return func(c *Config) {
+		c.maxReadBufSize = size
+	}
+}
+
+// WithBufferPool makes the Client borrow the buffer
+// where the message values are read into when decoding
+// from the pool p of *bytes.Buffer for the duration of a method call,
+// instead of keeping its own buffer which grows with the largest reply.
+// That reduces memory usage when many Clients are pooled,
+// since the idle ones don't hold onto their buffers.
+// The pool's New func is optional.
+//
+// The decoded strings are copied from the buffer by the string converter,
+// so the pool doesn't change which values can be retained by a caller.
+// A Watcher keeps reading into the Client's own buffer,
+// because it waits for the signals indefinitely.
+func WithBufferPool(p *sync.Pool) Option {
+	return func(c *Config) {
+		c.bufPool = p
+	}
+}
+
+// WithMaxArrayElements limits the number of elements
+// decoded from an array in a reply, e.g., the units of ListUnits,
+// so a malformed array length can't make the Client spin
+// through millions of elements.
+// Decoding fails with ErrTooManyElements once the limit is exceeded.
+// Zero means there is no limit.
+// By default DefaultMaxArrayElements is used.
+func WithMaxArrayElements(n int) Option {
+	return func(c *Config) {
+		if n >= 0 {
+			c.maxArrayElems = n
+		}
+	}
+}
+
+// WithStreamEncoding makes the Client write a message into the connection
+// in chunks of the given size as the message is being encoded,
+// instead of buffering the whole message before writing.
+// That saves memory when sending large messages at the cost of CPU,
+// because the message body is encoded twice:
+// to compute its length and then to write it.
+//
+// Note, if writing fails midway, the connection can't be reused
+// since the message bus received a part of the message.
+func WithStreamEncoding(chunkSize int) Option {
+	return func(c *Config) {
+		c.streamEncSize = chunkSize
+	}
+}
+
 // WithSerialCheck enables checking of message serials,
 // i.e., the Client will compare the serial number sent within a message to D-Bus
 // with the serial received in the reply.
+// It also verifies that the replies use D-Bus protocol version 1.
 //
 // Note, this requires decoding of header fields which incurs extra allocs.
 // There shouldn't be any request/reply mishmash because
@@ -88,3 +228,265 @@ func WithSerialCheck() Option {
 		c.isSerialCheckEnabled = true
 	}
 }
+
+// WithStrictDecoding enables validation of the received messages
+// at the cost of decoding the header fields, i.e.,
+// the Client rejects a message with
+// an unsupported D-Bus protocol version, a zero serial,
+// a type other than a method reply, error, or signal where a reply is expected,
+// or a body which wasn't entirely decoded, e.g.,
+// when systemd returned more arguments than the Client expected.
+//
+// By default these messages are decoded on a best-effort basis,
+// so the mode suits staging environments rather than the hot path.
+func WithStrictDecoding() Option {
+	return func(c *Config) {
+		c.isStrictDecodingEnabled = true
+	}
+}
+
+// WithUnixFDs enables passing of Unix file descriptors over the connection,
+// e.g., to look up a unit by a pidfd with GetUnitByPIDFD.
+// The Client negotiates it with the message bus during authentication,
+// and the connection fails if the bus doesn't support it.
+func WithUnixFDs() Option {
+	return func(c *Config) {
+		c.isUnixFDEnabled = true
+	}
+}
+
+// WithKeepAlive enables pinging of the message bus
+// when the connection has been idle for the given interval,
+// so the idle connection isn't dropped.
+// The ping is skipped if a method call is in progress.
+func WithKeepAlive(interval time.Duration) Option {
+	return func(c *Config) {
+		c.keepAliveInterval = interval
+	}
+}
+
+// WithInitialSerial sets the serial of the first message sent over a new connection,
+// so the following messages are numbered predictably starting from it,
+// e.g., to reproduce the Client's messages in tests.
+// The serial must not be zero, so zero is replaced with 1 which is the default.
+func WithInitialSerial(n uint32) Option {
+	return func(c *Config) {
+		if n == 0 {
+			n = 1
+		}
+		c.initialSerial = n
+	}
+}
+
+// WithoutHello skips Hello message which is sent after auth
+// to obtain a unique connection name from the message bus.
+// It's needed for a direct peer-to-peer connection
+// to a service which speaks D-Bus without a message bus,
+// because such a peer doesn't implement Hello method.
+// The first method call gets the initial serial instead of Hello message.
+//
+// Since there is no connection name, WithSerialCheck
+// only compares the serials, not the destination of the replies.
+func WithoutHello() Option {
+	return func(c *Config) {
+		c.isHelloDisabled = true
+	}
+}
+
+// WithCgroupFallback makes Client.GetUnitProcesses work with systemd
+// older than v238 which lacks GetUnitProcesses method.
+// In that case the processes are read from cgroup.procs files
+// of the unit's control group (ControlGroup property) and its children
+// under /sys/fs/cgroup, and their command lines are read from /proc.
+// Note, this requires the Client to run on the same host as systemd.
+func WithCgroupFallback() Option {
+	return func(c *Config) {
+		c.isCgroupFallbackEnabled = true
+	}
+}
+
+// WithUnitsCapacityHint preallocates the slice returned by Client.Units
+// to hold n units, e.g., roughly the number of units on the host,
+// so the slice doesn't grow when it's filled in.
+// Zero or negative n is ignored.
+func WithUnitsCapacityHint(n int) Option {
+	return func(c *Config) {
+		if n > 0 {
+			c.unitsCapHint = n
+		}
+	}
+}
+
+// WithUnitBufferReuse makes the Client reuse the buffers
+// where the unit fields are stored across ListUnits calls,
+// so repeated calls approach zero allocs in the steady state,
+// e.g., when an exporter lists the units every few seconds.
+//
+// The catch is that the Unit fields (strings) are overwritten
+// by the next ListUnits, ListUnitsByPatterns, ListUnitsByState,
+// Units, FindUnit, or ListUnitsWithProperties call,
+// so they must be copied (e.g., with strings.Clone) to be retained.
+func WithUnitBufferReuse() Option {
+	return func(c *Config) {
+		c.isUnitBufferReuseEnabled = true
+	}
+}
+
+// WithListBatchSize splits the patterns of ListUnitsByPatterns
+// into batches of n patterns, so a call is made per batch.
+// That bounds the size of each reply and the time the connection is held
+// when many patterns are listed on a host with thousands of units.
+// By default, all the patterns are sent at once.
+// Non-positive n is ignored.
+func WithListBatchSize(n int) Option {
+	return func(c *Config) {
+		if n > 0 {
+			c.listBatchSize = n
+		}
+	}
+}
+
+// WithAuth sets the auth mechanisms which the Client tries in order
+// until the server accepts one, e.g., WithAuth(AuthExternal, AuthAnonymous).
+// When the server rejects a mechanism, it lists the ones it supports,
+// so the following mechanisms which the server doesn't support are skipped.
+// If none of them is accepted, the error of each attempt (*AuthError)
+// is returned joined with errors.Join.
+// By default, only EXTERNAL mechanism is used.
+func WithAuth(mechs ...string) Option {
+	return func(c *Config) {
+		if len(mechs) > 0 {
+			c.authMechs = mechs
+		}
+	}
+}
+
+// WithAuthUID sets the Unix uid the Client authenticates as
+// using EXTERNAL mechanism, e.g., when a setuid helper
+// connects on behalf of another user.
+// By default, it's the effective uid of the process.
+// Note, the message bus rejects the uid which doesn't match
+// the credentials of the connection's peer unless the peer is privileged.
+// Negative uid is ignored.
+func WithAuthUID(uid int) Option {
+	return func(c *Config) {
+		if uid >= 0 {
+			c.authUID = uid
+		}
+	}
+}
+
+// WithConnectionFactory sets a func which obtains a new connection
+// to the message bus whenever the Client connects, i.e.,
+// in New, Reconnect, and the Client's methods which need a separate connection
+// such as StartUnitAndWait.
+// It replaces dialing the bus address, e.g.,
+// to reach the message bus over an SSH tunnel.
+// The context bounds the time spent connecting.
+// Note, the file descriptor passing (WithUnixFDs) requires a *net.UnixConn.
+func WithConnectionFactory(f func(ctx context.Context) (net.Conn, error)) Option {
+	return func(c *Config) {
+		c.connFactory = f
+	}
+}
+
+// Message flags that can be set on a method call with WithCallFlags.
+const (
+	// NoReplyExpected indicates that a caller doesn't expect a reply,
+	// so the Client won't wait for it.
+	NoReplyExpected = flagNoReplyExpected
+	// NoAutoStart prevents the bus from auto-starting
+	// the destination service in response to a method call.
+	NoAutoStart = flagNoAutoStart
+	// AllowInteractiveAuthorization indicates that a caller is prepared
+	// to wait for interactive authorization, e.g., a polkit prompt.
+	AllowInteractiveAuthorization = flagAllowInteractiveAuthorization
+)
+
+// callConfig represents a config of a single method call.
+type callConfig struct {
+	// flags is a bitwise OR of message flags set in the message header.
+	flags byte
+	// timeout overrides the connection timeout for a method call if set.
+	timeout time.Duration
+	// destination overrides the bus name a method call is sent to if set.
+	destination string
+	// readSize is a hint of the read buffer size for a method call.
+	readSize int
+	// stats is where the statistics of a method call are collected if set.
+	stats *CallStats
+}
+
+// CallOption sets up a callConfig.
+type CallOption func(*callConfig)
+
+// WithCallFlags sets message flags of a method call,
+// e.g., WithCallFlags(NoAutoStart).
+// The flags are OR'd into the header flags.
+func WithCallFlags(flags byte) CallOption {
+	return func(c *callConfig) {
+		c.flags |= flags
+	}
+}
+
+// WithCallTimeout sets the read and write timeouts
+// of a method call overriding the connection timeout set by WithTimeout.
+func WithCallTimeout(timeout time.Duration) CallOption {
+	return func(c *callConfig) {
+		c.timeout = timeout
+	}
+}
+
+// WithCallDestination sends a method call to the given bus name
+// instead of the default one, e.g., org.freedesktop.systemd1.
+// It's useful when systemd is reachable under another name,
+// e.g., a unique connection name such as ":1.5".
+func WithCallDestination(name string) CallOption {
+	return func(c *callConfig) {
+		c.destination = name
+	}
+}
+
+// WithCallReadSize hints the size of a buffer
+// which is used for reading the reply of a method call,
+// e.g., 64 KiB for Dump or ListUnits on a host with many units,
+// so the large reply is read with less syscalls.
+// The hint is ignored if it's smaller than the size set by WithConnectionReadSize.
+// The larger buffer is kept by the Client to be reused by the following calls.
+func WithCallReadSize(size int) CallOption {
+	return func(c *callConfig) {
+		c.readSize = size
+	}
+}
+
+// CallStats represents the statistics of a method call collected with CollectStats,
+// e.g., to find out where a slow host spends its time.
+type CallStats struct {
+	// WireBytes is a number of bytes of the method call and its reply
+	// that were sent and received over the connection.
+	// The signals received before the reply are not accounted.
+	WireBytes int
+	// EncodeDuration is how long it took to encode the method call
+	// and write it into the connection.
+	EncodeDuration time.Duration
+	// WaitDuration is how long the Client waited for the reply to arrive
+	// after the method call was sent.
+	WaitDuration time.Duration
+	// DecodeDuration is how long it took to read and decode the reply
+	// once it started arriving, including the Client's callback
+	// such as f in ListUnits.
+	DecodeDuration time.Duration
+	// Latency is the total duration of the method call.
+	Latency time.Duration
+}
+
+// CollectStats makes a method call collect its statistics into s.
+// The stats are added to s, so a method which makes several calls
+// (e.g., ListUnitsByPatterns with WithListBatchSize) sums them up.
+// Reset s before reusing it for another method call.
+// By default, the stats aren't collected.
+func CollectStats(s *CallStats) CallOption {
+	return func(c *callConfig) {
+		c.stats = s
+	}
+}
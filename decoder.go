@@ -3,7 +3,10 @@ package systemd
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"io"
+	"math"
+	"sync"
 	"unsafe"
 )
 
@@ -27,6 +30,24 @@ type decoder struct {
 	// which is used solely to determine the alignment.
 	// The offset is limited by maxMessageSize.
 	offset uint32
+	// fds are Unix file descriptors that accompany the message.
+	fds []int
+	// maxBufSize limits how much the buffer can grow when reading.
+	// Zero means there is no limit.
+	maxBufSize int
+	// limit is the offset where the message body ends,
+	// so the decoder can't read past it.
+	// It is only respected when hasLimit is set.
+	limit    uint32
+	hasLimit bool
+	// maxArrayElems limits the number of elements decoded from an array.
+	// Zero means there is no limit.
+	maxArrayElems int
+	// bufPool lends buf to the decoder, see BorrowBuffer.
+	// It is nil if the decoder keeps its own buffer.
+	bufPool *sync.Pool
+	// ownBuf is the decoder's own buffer while buf is borrowed from the pool.
+	ownBuf *bytes.Buffer
 }
 
 // Reset resets the decoder to be reading from src
@@ -34,6 +55,89 @@ type decoder struct {
 func (d *decoder) Reset(src io.Reader) {
 	d.src = src
 	d.offset = 0
+	d.hasLimit = false
+}
+
+// SetSource sets src to read the rest of the message from
+// keeping the current offset, e.g., when switching to the body reader
+// limited by the body length after the header was decoded.
+// That way the alignment stays relative to the message start.
+func (d *decoder) SetSource(src io.Reader) {
+	d.src = src
+}
+
+// SetLimit limits the decoder to read at most n more bytes,
+// e.g., the body length once the header was decoded.
+// Reading past the limit fails with ErrBodyOverrun
+// instead of running into EOF in the middle of a value.
+// The limit is removed by Reset.
+func (d *decoder) SetLimit(n uint32) {
+	d.limit = d.offset + n
+	d.hasLimit = true
+}
+
+// Offset returns a current position in the message.
+func (d *decoder) Offset() uint32 {
+	return d.offset
+}
+
+// SetUnixFDs sets the Unix file descriptors which accompany the message,
+// so that UNIX_FD indices in the message body can be mapped to them.
+func (d *decoder) SetUnixFDs(fds []int) {
+	d.fds = fds
+}
+
+// SetMaxBufferSize limits the size of the buffer used for reading to n bytes,
+// so a peer can't make the decoder allocate a huge buffer
+// by declaring an oversized string.
+// Zero means there is no limit.
+func (d *decoder) SetMaxBufferSize(n int) {
+	d.maxBufSize = n
+}
+
+// SetMaxArrayElements limits the number of elements decoded from an array to n,
+// so a garbled array length can't keep the decoder busy
+// with millions of tiny elements.
+// Zero means there is no limit.
+func (d *decoder) SetMaxArrayElements(n int) {
+	d.maxArrayElems = n
+}
+
+// SetBufferPool sets the pool of *bytes.Buffer
+// which the decoder borrows its buffer from, see BorrowBuffer.
+func (d *decoder) SetBufferPool(p *sync.Pool) {
+	d.bufPool = p
+}
+
+// BorrowBuffer replaces the decoder's buffer with the one from the pool
+// until ReturnBuffer is called, so the idle decoder doesn't hold onto
+// a buffer grown by a large message.
+// It does nothing if the pool isn't set or the buffer is already borrowed.
+func (d *decoder) BorrowBuffer() {
+	if d.bufPool == nil || d.ownBuf != nil {
+		return
+	}
+
+	buf, _ := d.bufPool.Get().(*bytes.Buffer)
+	if buf == nil {
+		buf = &bytes.Buffer{}
+	}
+	d.ownBuf = d.buf
+	d.buf = buf
+}
+
+// ReturnBuffer puts the borrowed buffer back into the pool
+// and restores the decoder's own buffer.
+// The byte slices returned by the decoder must not be used afterwards.
+func (d *decoder) ReturnBuffer() {
+	if d.ownBuf == nil {
+		return
+	}
+
+	d.buf.Reset()
+	d.bufPool.Put(d.buf)
+	d.buf = d.ownBuf
+	d.ownBuf = nil
 }
 
 // SetOrder sets a byte order used in decoding.
@@ -48,40 +152,163 @@ func (d *decoder) Align(n uint32) error {
 		return nil
 	}
 
+	if err := d.checkLimit(padding); err != nil {
+		return err
+	}
+
 	_, err := readN(d.src, d.buf, int(padding))
 	d.offset = offset
 	return err
 }
 
 // ReadN reads exactly n bytes without decoding.
+// It fails if n exceeds the max buffer size.
 func (d *decoder) ReadN(n uint32) ([]byte, error) {
+	if err := d.checkLimit(n); err != nil {
+		return nil, err
+	}
+	if err := d.checkBufferSize(uint64(n)); err != nil {
+		return nil, err
+	}
+
 	d.offset += n
 	return readN(d.src, d.buf, int(n))
 }
 
+// Skip advances the decoder by exactly n bytes discarding them.
+// Unlike ReadN, it reads in chunks if n exceeds the max buffer size,
+// so the buffer doesn't grow beyond that size.
+func (d *decoder) Skip(n uint32) error {
+	if err := d.checkLimit(n); err != nil {
+		return err
+	}
+
+	chunk := n
+	if d.maxBufSize > 0 && chunk > uint32(d.maxBufSize) {
+		chunk = uint32(d.maxBufSize)
+	}
+
+	for n > 0 {
+		if chunk > n {
+			chunk = n
+		}
+		if _, err := readN(d.src, d.buf, int(chunk)); err != nil {
+			return err
+		}
+
+		d.offset += chunk
+		n -= chunk
+	}
+
+	return nil
+}
+
+// checkBufferSize returns an error if reading n bytes
+// would grow the buffer beyond the max buffer size.
+func (d *decoder) checkBufferSize(n uint64) error {
+	if d.maxBufSize > 0 && n > uint64(d.maxBufSize) {
+		return fmt.Errorf("read exceeded the max buffer size: %d/%d bytes", n, d.maxBufSize)
+	}
+	return nil
+}
+
+// checkLimit returns ErrBodyOverrun if reading n bytes
+// would go past the limit set by SetLimit.
+func (d *decoder) checkLimit(n uint32) error {
+	if !d.hasLimit {
+		return nil
+	}
+
+	var rest uint32
+	if d.offset < d.limit {
+		rest = d.limit - d.offset
+	}
+	if n > rest {
+		return fmt.Errorf("%w: %d/%d bytes", ErrBodyOverrun, n, rest)
+	}
+	return nil
+}
+
+// checkArrayElements returns ErrTooManyElements
+// if the n-th array element exceeds the limit set by SetMaxArrayElements.
+func (d *decoder) checkArrayElements(n int) error {
+	if d.maxArrayElems > 0 && n > d.maxArrayElems {
+		return fmt.Errorf("%w: over %d", ErrTooManyElements, d.maxArrayElems)
+	}
+	return nil
+}
+
+// checkStringLen returns an error if a string of the declared length
+// (followed by a null byte) doesn't fit into the rest of the message
+// which can't exceed the maximum message length.
+// That way a garbled length prefix is rejected before the buffer grows.
+func (d *decoder) checkStringLen(strLen uint32) error {
+	var rest uint32
+	if d.offset < maxMsgSize {
+		rest = maxMsgSize - d.offset
+	}
+	if strLen >= rest {
+		return fmt.Errorf("string exceeded the remaining message length: %d/%d bytes", uint64(strLen)+1, rest)
+	}
+	return nil
+}
+
 // Byte decodes D-Bus BYTE.
 func (d *decoder) Byte() (byte, error) {
+	start := d.offset
+	if err := d.checkLimit(1); err != nil {
+		return 0, newDecodeError("BYTE", start, err)
+	}
+
 	b, err := readN(d.src, d.buf, 1)
 	if err != nil {
-		return 0, err
+		return 0, newDecodeError("BYTE", start, err)
 	}
 
 	d.offset++
 	return b[0], nil
 }
 
+const u16size = 2
+
+// Uint16 decodes D-Bus UINT16.
+func (d *decoder) Uint16() (uint16, error) {
+	start, _ := nextOffset(d.offset, u16size)
+	err := d.Align(u16size)
+	if err != nil {
+		return 0, newDecodeError("UINT16", start, err)
+	}
+	if err = d.checkLimit(u16size); err != nil {
+		return 0, newDecodeError("UINT16", start, err)
+	}
+
+	b, err := readN(d.src, d.buf, u16size)
+	if err != nil {
+		return 0, newDecodeError("UINT16", start, err)
+	}
+
+	u := d.order.Uint16(b)
+	// 2 bytes were read because uint16 takes 2 bytes.
+	d.offset += u16size
+	return u, nil
+}
+
 const u32size = 4
 
 // Uint32 decodes D-Bus UINT32.
 func (d *decoder) Uint32() (uint32, error) {
+	start, _ := nextOffset(d.offset, u32size)
 	err := d.Align(u32size)
 	if err != nil {
-		return 0, err
+		return 0, newDecodeError("UINT32", start, err)
+	}
+	if err = d.checkLimit(u32size); err != nil {
+		return 0, newDecodeError("UINT32", start, err)
 	}
 
 	b, err := readN(d.src, d.buf, u32size)
 	if err != nil {
-		return 0, err
+		return 0, newDecodeError("UINT32", start, err)
 	}
 
 	u := d.order.Uint32(b)
@@ -90,46 +317,703 @@ func (d *decoder) Uint32() (uint32, error) {
 	return u, nil
 }
 
+const u64size = 8
+
+// Uint64 decodes D-Bus UINT64.
+func (d *decoder) Uint64() (uint64, error) {
+	start, _ := nextOffset(d.offset, u64size)
+	err := d.Align(u64size)
+	if err != nil {
+		return 0, newDecodeError("UINT64", start, err)
+	}
+	if err = d.checkLimit(u64size); err != nil {
+		return 0, newDecodeError("UINT64", start, err)
+	}
+
+	b, err := readN(d.src, d.buf, u64size)
+	if err != nil {
+		return 0, newDecodeError("UINT64", start, err)
+	}
+
+	u := d.order.Uint64(b)
+	// 8 bytes were read because uint64 takes 8 bytes.
+	d.offset += u64size
+	return u, nil
+}
+
+// UnixFD decodes D-Bus UNIX_FD which is an index
+// into the array of file descriptors that accompany the message,
+// and returns the file descriptor found at that index.
+// The caller owns the returned file descriptor,
+// i.e., it is responsible for closing it.
+func (d *decoder) UnixFD() (int, error) {
+	idx, err := d.UnixFDIndex()
+	if err != nil {
+		return 0, err
+	}
+	return d.ClaimUnixFD(idx)
+}
+
+// UnixFDIndex decodes D-Bus UNIX_FD as is, i.e.,
+// the index into the array of file descriptors that accompany the message.
+// It's useful when the index is looked up later, see ClaimUnixFD.
+func (d *decoder) UnixFDIndex() (uint32, error) {
+	return d.Uint32()
+}
+
+// ClaimUnixFD returns the file descriptor found at the index idx
+// of the file descriptors received along with the message.
+// The caller owns the returned file descriptor,
+// so it can't be claimed twice.
+func (d *decoder) ClaimUnixFD(idx uint32) (int, error) {
+	if int(idx) >= len(d.fds) || d.fds[idx] < 0 {
+		return 0, fmt.Errorf("unix fd not found at index %d", idx)
+	}
+
+	// Mark the file descriptor as claimed,
+	// so it won't be closed when the next message is decoded.
+	fd := d.fds[idx]
+	d.fds[idx] = -1
+	return fd, nil
+}
+
 // String decodes D-Bus STRING or OBJECT_PATH.
 // A caller must not retain the returned byte slice.
 // The string conversion is not done here to avoid allocations.
 func (d *decoder) String() ([]byte, error) {
+	start, _ := nextOffset(d.offset, u32size)
 	strLen, err := d.Uint32()
 	if err != nil {
-		return nil, err
+		return nil, newDecodeError("STRING", start, err)
+	}
+	if err = d.checkStringLen(strLen); err != nil {
+		return nil, newDecodeError("STRING", start, err)
+	}
+	if err = d.checkLimit(strLen + 1); err != nil {
+		return nil, newDecodeError("STRING", start, err)
+	}
+	if err = d.checkBufferSize(uint64(strLen) + 1); err != nil {
+		return nil, newDecodeError("STRING", start, err)
 	}
 
 	// Read the string content
 	// accounting for a null byte at the end of the string.
 	b, err := readN(d.src, d.buf, int(strLen)+1)
 	if err != nil {
-		return nil, err
+		return nil, newDecodeError("STRING", start, err)
 	}
 	d.offset += strLen + 1
 
 	return b[:strLen], nil
 }
 
+// SkipString discards D-Bus STRING or OBJECT_PATH
+// which is cheaper than String when the value isn't needed,
+// because a long string doesn't grow the buffer.
+func (d *decoder) SkipString() error {
+	start, _ := nextOffset(d.offset, u32size)
+	strLen, err := d.Uint32()
+	if err != nil {
+		return newDecodeError("STRING", start, err)
+	}
+	if err = d.checkStringLen(strLen); err != nil {
+		return newDecodeError("STRING", start, err)
+	}
+
+	// Account for a null byte at the end of the string.
+	if err = d.Skip(strLen + 1); err != nil {
+		return newDecodeError("STRING", start, err)
+	}
+	return nil
+}
+
+// SkipStruct discards D-Bus STRUCT with the given signature, e.g., "(so)",
+// without converting its fields to Go types.
+func (d *decoder) SkipStruct(sig string) error {
+	if sig == "" || sig[0] != '(' {
+		return fmt.Errorf("not a struct signature: %s", sig)
+	}
+
+	rest, err := d.skipValue(sig, 0)
+	if err != nil {
+		return err
+	}
+	if rest != "" {
+		return fmt.Errorf("struct signature must be a single complete type: %s", sig)
+	}
+
+	return nil
+}
+
+// Struct decodes D-Bus STRUCT with the given signature, e.g., "(usssoo)",
+// into dst which holds a pointer per field in the signature's order.
+// A nil pointer discards the field.
+// Only basic types except UNIX_FD are supported as the struct fields:
+// string, object path, and signature are decoded into *string,
+// boolean into *bool, and the numeric types into pointers
+// to their Go counterparts, e.g., uint32 into *uint32.
+func (d *decoder) Struct(conv *stringConverter, sig string, dst []any) error {
+	if len(sig) < 2 || sig[0] != '(' || sig[len(sig)-1] != ')' {
+		return fmt.Errorf("not a struct signature: %s", sig)
+	}
+	fields := sig[1 : len(sig)-1]
+	if len(fields) != len(dst) {
+		return fmt.Errorf("struct %s has %d fields, got %d destinations", sig, len(fields), len(dst))
+	}
+
+	// STRUCT is always aligned to an 8-byte boundary,
+	// regardless of the alignments of its contents.
+	if err := d.Align(8); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(fields); i++ {
+		if err := d.structField(conv, fields[i], dst[i]); err != nil {
+			return fmt.Errorf("struct %s field %d: %w", sig, i, err)
+		}
+	}
+
+	return nil
+}
+
+// structField decodes a struct field of the basic type t into dst.
+func (d *decoder) structField(conv *stringConverter, t byte, dst any) error {
+	switch t {
+	case typeVariant, typeUnixFD, typeArray, '(', ')', '{', '}':
+		return fmt.Errorf("unsupported type: %c", t)
+	}
+	if dst == nil {
+		_, err := d.skipValue(string(t), 0)
+		return err
+	}
+
+	var v Variant
+	if err := d.Value(conv, string(t), &v); err != nil {
+		return err
+	}
+
+	switch p := dst.(type) {
+	case *string:
+		if t == typeString || t == typeObjectPath || t == typeSignature {
+			*p = v.S
+			return nil
+		}
+	case *bool:
+		if t == typeBoolean {
+			*p = v.U != 0
+			return nil
+		}
+	case *byte:
+		if t == typeByte {
+			*p = byte(v.U)
+			return nil
+		}
+	case *uint16:
+		if t == typeUint16 {
+			*p = uint16(v.U)
+			return nil
+		}
+	case *int16:
+		if t == typeInt16 {
+			*p = int16(v.I)
+			return nil
+		}
+	case *uint32:
+		if t == typeUint32 {
+			*p = uint32(v.U)
+			return nil
+		}
+	case *int32:
+		if t == typeInt32 {
+			*p = int32(v.I)
+			return nil
+		}
+	case *uint64:
+		if t == typeUint64 {
+			*p = v.U
+			return nil
+		}
+	case *int64:
+		if t == typeInt64 {
+			*p = v.I
+			return nil
+		}
+	case *float64:
+		if t == typeDouble {
+			*p = v.F
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot decode type %c into %T", t, dst)
+}
+
 // Signature decodes D-Bus SIGNATURE
 // which is the same as STRING except the length is a single byte
 // (thus signatures have a maximum length of 255).
 func (d *decoder) Signature() ([]byte, error) {
+	start := d.offset
 	strLen, err := d.Byte()
 	if err != nil {
-		return nil, err
+		return nil, newDecodeError("SIGNATURE", start, err)
+	}
+	if err = d.checkStringLen(uint32(strLen)); err != nil {
+		return nil, newDecodeError("SIGNATURE", start, err)
+	}
+	if err = d.checkLimit(uint32(strLen) + 1); err != nil {
+		return nil, newDecodeError("SIGNATURE", start, err)
 	}
 
 	// Read the string content
 	// accounting for a null byte at the end of the string.
 	b, err := readN(d.src, d.buf, int(strLen)+1)
 	if err != nil {
-		return nil, err
+		return nil, newDecodeError("SIGNATURE", start, err)
 	}
 	d.offset += uint32(strLen) + 1
 
 	return b[:strLen], nil
 }
 
+// Variant decodes D-Bus VARIANT into v.
+// Variants are marshaled as the SIGNATURE of the contents
+// (which must be a single complete type),
+// followed by a marshaled value with the type given by that signature.
+// Basic types, "as", and "(ss)" are decoded,
+// other types are returned as RawVariant.
+func (d *decoder) Variant(conv *stringConverter, v *Variant) error {
+	sign, err := d.Signature()
+	if err != nil {
+		*v = Variant{}
+		return err
+	}
+	// The signature must be copied before the next read,
+	// because its bytes are overwritten by the value.
+	return d.Value(conv, conv.String(sign), v)
+}
+
+// Value decodes a value of the given signature
+// (single complete type) into v similar to Variant,
+// e.g., an argument of a signal.
+func (d *decoder) Value(conv *stringConverter, sig string, v *Variant) error {
+	*v = Variant{Signature: sig}
+	switch len(sig) {
+	case 0:
+		return fmt.Errorf("empty variant signature")
+	case 1:
+	default:
+		return d.containerVariant(conv, v)
+	}
+
+	var (
+		err error
+		b   byte
+		u16 uint16
+		u32 uint32
+		u64 uint64
+		s   []byte
+	)
+	switch v.Signature[0] {
+	case typeByte:
+		b, err = d.Byte()
+		v.U = uint64(b)
+	case typeUint16:
+		u16, err = d.Uint16()
+		v.U = uint64(u16)
+	case typeInt16:
+		u16, err = d.Uint16()
+		v.I = int64(int16(u16))
+	case typeBoolean, typeUint32:
+		u32, err = d.Uint32()
+		v.U = uint64(u32)
+	case typeInt32:
+		u32, err = d.Uint32()
+		v.I = int64(int32(u32))
+	case typeUint64:
+		v.U, err = d.Uint64()
+	case typeInt64:
+		u64, err = d.Uint64()
+		v.I = int64(u64)
+	case typeDouble:
+		u64, err = d.Uint64()
+		v.F = math.Float64frombits(u64)
+	case typeString, typeObjectPath:
+		if s, err = d.String(); err == nil {
+			v.S = conv.String(s)
+		}
+	case typeSignature:
+		if s, err = d.Signature(); err == nil {
+			v.S = conv.String(s)
+		}
+	default:
+		// VARIANT and UNIX_FD are returned undecoded.
+		return d.rawVariant(v)
+	}
+
+	return err
+}
+
+// containerVariant decodes the value of a variant v
+// whose signature denotes a container type.
+func (d *decoder) containerVariant(conv *stringConverter, v *Variant) error {
+	switch v.Signature {
+	case "as":
+		arrLen, err := d.Uint32()
+		if err != nil {
+			return err
+		}
+		if arrLen > maxArrayLen {
+			return fmt.Errorf("array exceeded the maximum length: %d/%d bytes", arrLen, maxArrayLen)
+		}
+		// A garbled length mustn't move the end of the array
+		// past the message body.
+		if err = d.checkLimit(arrLen); err != nil {
+			return err
+		}
+
+		var (
+			end = d.offset + arrLen
+			s   []byte
+		)
+		// The strings don't need the alignment
+		// since they follow the array length which is 4-byte aligned.
+		for n := 1; d.offset < end; n++ {
+			if err = d.checkArrayElements(n); err != nil {
+				return err
+			}
+			if s, err = d.String(); err != nil {
+				return err
+			}
+			v.Strings = append(v.Strings, conv.String(s))
+		}
+
+		return nil
+
+	case "(ss)":
+		if err := d.Align(8); err != nil {
+			return err
+		}
+
+		v.Strings = make([]string, 2)
+		for i := range v.Strings {
+			s, err := d.String()
+			if err != nil {
+				return err
+			}
+			v.Strings[i] = conv.String(s)
+		}
+
+		return nil
+	}
+
+	// The arrays of other types are returned undecoded.
+	// Since the array length is known in advance,
+	// the elements can be read without parsing the signature.
+	if v.Signature[0] != typeArray {
+		return d.rawVariant(v)
+	}
+
+	arrLen, err := d.Uint32()
+	if err != nil {
+		return err
+	}
+	// The padding after the array length is added
+	// even if the array is empty.
+	if err = d.Align(alignment(v.Signature[1])); err != nil {
+		return err
+	}
+
+	raw := RawVariant{
+		Signature: v.Signature,
+		Offset:    d.offset % 8,
+	}
+	if arrLen > 0 {
+		b, err := d.ReadN(arrLen)
+		if err != nil {
+			return err
+		}
+		raw.Bytes = append([]byte(nil), b...)
+	}
+	v.Raw = &raw
+
+	return nil
+}
+
+// rawVariant reads the value of a variant v undecoded,
+// e.g., a struct "(uo)" or a nested variant,
+// and returns it as RawVariant.
+func (d *decoder) rawVariant(v *Variant) error {
+	if err := d.Align(alignment(v.Signature[0])); err != nil {
+		return err
+	}
+
+	raw := RawVariant{
+		Signature: v.Signature,
+		Offset:    d.offset % 8,
+	}
+	rest, b, err := d.rawValue(v.Signature, nil, 0)
+	if err != nil {
+		return err
+	}
+	if rest != "" {
+		return fmt.Errorf("variant signature must be a single complete type: %s", v.Signature)
+	}
+	raw.Bytes = b
+	v.Raw = &raw
+
+	return nil
+}
+
+// maxNestingDepth is the maximum depth of nested containers,
+// see https://dbus.freedesktop.org/doc/dbus-specification.html#message-protocol-marshaling.
+const maxNestingDepth = 64
+
+// rawValue reads the value of the first single complete type in sig,
+// and appends its encoded bytes (including the alignment padding) to raw.
+// It returns the remaining signature.
+func (d *decoder) rawValue(sig string, raw []byte, depth int) (string, []byte, error) {
+	if depth > maxNestingDepth {
+		return "", raw, fmt.Errorf("container nesting exceeded the max depth %d", maxNestingDepth)
+	}
+	if sig == "" {
+		return "", raw, fmt.Errorf("incomplete signature")
+	}
+
+	var (
+		t   = sig[0]
+		err error
+	)
+	if _, padding := nextOffset(d.offset, alignment(t)); padding > 0 {
+		if raw, err = d.appendN(raw, padding); err != nil {
+			return "", raw, err
+		}
+	}
+
+	var b []byte
+	switch t {
+	case typeByte:
+		raw, err = d.appendN(raw, 1)
+	case typeInt16, typeUint16:
+		raw, err = d.appendN(raw, u16size)
+	case typeBoolean, typeInt32, typeUint32, typeUnixFD:
+		raw, err = d.appendN(raw, u32size)
+	case typeInt64, typeUint64, typeDouble:
+		raw, err = d.appendN(raw, u64size)
+
+	case typeString, typeObjectPath:
+		if b, err = d.ReadN(u32size); err != nil {
+			return "", raw, err
+		}
+		raw = append(raw, b...)
+		// Account for a null byte at the end of the string.
+		raw, err = d.appendN(raw, d.order.Uint32(b)+1)
+
+	case typeSignature, typeVariant:
+		if b, err = d.ReadN(1); err != nil {
+			return "", raw, err
+		}
+		raw = append(raw, b...)
+		if b, err = d.ReadN(uint32(b[0]) + 1); err != nil {
+			return "", raw, err
+		}
+		raw = append(raw, b...)
+		if t == typeSignature {
+			break
+		}
+
+		// The variant's value follows its signature.
+		var rest string
+		varSig := string(b[:len(b)-1])
+		if rest, raw, err = d.rawValue(varSig, raw, depth+1); err != nil {
+			return "", raw, err
+		}
+		if rest != "" {
+			return "", raw, fmt.Errorf("variant signature must be a single complete type: %s", varSig)
+		}
+
+	case typeArray:
+		if b, err = d.ReadN(u32size); err != nil {
+			return "", raw, err
+		}
+		raw = append(raw, b...)
+		arrLen := d.order.Uint32(b)
+
+		var n int
+		if n, err = typeLen(sig[1:]); err != nil {
+			return "", raw, err
+		}
+		// The padding after the array length is added
+		// even if the array is empty.
+		if _, padding := nextOffset(d.offset, alignment(sig[1])); padding > 0 {
+			if raw, err = d.appendN(raw, padding); err != nil {
+				return "", raw, err
+			}
+		}
+		if arrLen > 0 {
+			raw, err = d.appendN(raw, arrLen)
+		}
+
+		return sig[1+n:], raw, err
+
+	case '(':
+		sig = sig[1:]
+		for sig != "" && sig[0] != ')' {
+			if sig, raw, err = d.rawValue(sig, raw, depth+1); err != nil {
+				return "", raw, err
+			}
+		}
+		if sig == "" {
+			return "", raw, fmt.Errorf("struct is not closed")
+		}
+
+	default:
+		return "", raw, fmt.Errorf("unknown type: %c", t)
+	}
+
+	return sig[1:], raw, err
+}
+
+// skipValue discards the value of the first single complete type in sig
+// similar to rawValue, and returns the remaining signature.
+func (d *decoder) skipValue(sig string, depth int) (string, error) {
+	if depth > maxNestingDepth {
+		return "", fmt.Errorf("container nesting exceeded the max depth %d", maxNestingDepth)
+	}
+	if sig == "" {
+		return "", fmt.Errorf("incomplete signature")
+	}
+
+	t := sig[0]
+	err := d.Align(alignment(t))
+	if err != nil {
+		return "", err
+	}
+
+	switch t {
+	case typeByte:
+		err = d.Skip(1)
+	case typeInt16, typeUint16:
+		err = d.Skip(u16size)
+	case typeBoolean, typeInt32, typeUint32, typeUnixFD:
+		err = d.Skip(u32size)
+	case typeInt64, typeUint64, typeDouble:
+		err = d.Skip(u64size)
+
+	case typeString, typeObjectPath:
+		err = d.SkipString()
+
+	case typeSignature:
+		_, err = d.Signature()
+
+	case typeVariant:
+		var b []byte
+		if b, err = d.Signature(); err != nil {
+			return "", err
+		}
+		// The variant's value follows its signature.
+		var rest string
+		varSig := string(b)
+		if rest, err = d.skipValue(varSig, depth+1); err != nil {
+			return "", err
+		}
+		if rest != "" {
+			return "", fmt.Errorf("variant signature must be a single complete type: %s", varSig)
+		}
+
+	case typeArray:
+		var arrLen uint32
+		if arrLen, err = d.Uint32(); err != nil {
+			return "", err
+		}
+
+		var n int
+		if n, err = typeLen(sig[1:]); err != nil {
+			return "", err
+		}
+		// The padding after the array length is added
+		// even if the array is empty.
+		if err = d.Align(alignment(sig[1])); err != nil {
+			return "", err
+		}
+		if arrLen > 0 {
+			err = d.Skip(arrLen)
+		}
+
+		return sig[1+n:], err
+
+	case '(':
+		sig = sig[1:]
+		for sig != "" && sig[0] != ')' {
+			if sig, err = d.skipValue(sig, depth+1); err != nil {
+				return "", err
+			}
+		}
+		if sig == "" {
+			return "", fmt.Errorf("struct is not closed")
+		}
+
+	default:
+		return "", fmt.Errorf("unknown type: %c", t)
+	}
+
+	return sig[1:], err
+}
+
+// appendN reads exactly n bytes and appends them to raw.
+func (d *decoder) appendN(raw []byte, n uint32) ([]byte, error) {
+	b, err := d.ReadN(n)
+	if err != nil {
+		return raw, err
+	}
+	return append(raw, b...), nil
+}
+
+// typeLen returns the length of the first single complete type in sig,
+// e.g., 4 for "(ss)" in "(ss)as".
+func typeLen(sig string) (int, error) {
+	if sig == "" {
+		return 0, fmt.Errorf("incomplete signature")
+	}
+
+	switch sig[0] {
+	case typeArray:
+		n, err := typeLen(sig[1:])
+		return n + 1, err
+	case '(', '{':
+		depth := 0
+		for i := 0; i < len(sig); i++ {
+			switch sig[i] {
+			case '(', '{':
+				depth++
+			case ')', '}':
+				depth--
+			}
+			if depth == 0 {
+				return i + 1, nil
+			}
+		}
+		return 0, fmt.Errorf("container is not closed: %s", sig)
+	default:
+		return 1, nil
+	}
+}
+
+// alignment returns the alignment in bytes
+// of the D-Bus type represented by the type code t.
+func alignment(t byte) uint32 {
+	switch t {
+	case typeInt16, typeUint16:
+		return 2
+	case typeBoolean, typeInt32, typeUint32, typeUnixFD, typeString, typeObjectPath, typeArray:
+		return 4
+	case typeInt64, typeUint64, typeDouble, '(', '{':
+		return 8
+	default:
+		// BYTE, SIGNATURE, and VARIANT.
+		return 1
+	}
+}
+
 // readN reads exactly n bytes from src into the buffer.
 // The buffer grows on demand.
 // The objective is to reduce memory allocs.
@@ -181,6 +1065,14 @@ func newStringConverter(capacity int) *stringConverter {
 	}
 }
 
+// newReusableStringConverter creates a string converter
+// which reuses its buffers after Reset, see stringConverter.
+func newReusableStringConverter(capacity int) *stringConverter {
+	c := newStringConverter(capacity)
+	c.bufs = [][]byte{c.buf}
+	return c
+}
+
 // stringConverter converts bytes to strings with less allocs.
 // The idea is to accumulate bytes in a buffer with specified capacity
 // and create strings with unsafe.String using bytes from a buffer.
@@ -190,14 +1082,36 @@ func newStringConverter(capacity int) *stringConverter {
 // Once a buffer is filled, a new one is created with the same capacity.
 // Old buffers will be eventually GC-ed
 // with no side effects to the returned strings.
+//
+// A reusable converter keeps the filled buffers instead,
+// and Reset rewinds it to the first buffer,
+// so there are no allocs in the steady state.
+// The strings returned before Reset are overwritten afterwards,
+// therefore they must not be retained.
 type stringConverter struct {
 	// buf is a temporary buffer where decoded strings are batched.
 	buf []byte
 	// offset is a buffer position where the last string was written.
 	offset int
+	// bufs are the buffers allocated by a reusable converter.
+	// It is nil if the converter isn't reusable.
+	bufs [][]byte
+	// bufIdx is the index of the current buffer in bufs.
+	bufIdx int
+}
+
+// Reset rewinds a reusable converter to its first buffer.
+// It has no effect on a converter which isn't reusable.
+func (c *stringConverter) Reset() {
+	if c.bufs == nil {
+		return
+	}
+
+	c.bufIdx = 0
+	c.buf = c.bufs[0][:0]
+	c.offset = 0
 }
 
-// String converts bytes to a string.
 func (c *stringConverter) String(b []byte) string {
 	n := len(b)
 	if n == 0 {
@@ -209,8 +1123,7 @@ func (c *stringConverter) String(b []byte) string {
 	}
 
 	if len(c.buf)+n > cap(c.buf) {
-		c.buf = make([]byte, 0, cap(c.buf))
-		c.offset = 0
+		c.nextBuffer()
 	}
 	c.buf = append(c.buf, b...)
 
@@ -219,3 +1132,22 @@ func (c *stringConverter) String(b []byte) string {
 	c.offset += n
 	return s
 }
+
+// nextBuffer replaces the filled buffer with an empty one.
+// A reusable converter takes the next buffer it allocated earlier if there is one.
+func (c *stringConverter) nextBuffer() {
+	c.offset = 0
+
+	if c.bufs == nil {
+		c.buf = make([]byte, 0, cap(c.buf))
+		return
+	}
+
+	c.bufIdx++
+	if c.bufIdx < len(c.bufs) {
+		c.buf = c.bufs[c.bufIdx][:0]
+		return
+	}
+	c.buf = make([]byte, 0, cap(c.buf))
+	c.bufs = append(c.bufs, c.buf)
+}
@@ -2,6 +2,7 @@ package systemd
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"testing"
 
@@ -20,6 +21,667 @@ func TestDecodeString(t *testing.T) {
 	}
 }
 
+func TestDecodeStringMaxBuffer(t *testing.T) {
+	d := newDecoder(bytes.NewReader(testString))
+	d.SetMaxBufferSize(32)
+
+	_, err := d.String()
+	errMsg := "failed decoding STRING at offset 0: read exceeded the max buffer size: 66/32 bytes"
+	if err == nil || errMsg != err.Error() {
+		t.Fatalf("expected error %q got %q", errMsg, err)
+	}
+}
+
+func TestDecodeStringLen(t *testing.T) {
+	tt := map[string]struct {
+		in     []byte
+		offset uint32
+		want   string
+	}{
+		"absurd length": {
+			in:   []byte{0x00, 0x05, 0x01, 0x75, 'a', 0},
+			want: "failed decoding STRING at offset 0: string exceeded the remaining message length: 1963001089/134217724 bytes",
+		},
+		"max message length": {
+			in:   []byte{0x00, 0x00, 0x00, 0x08, 'a', 0},
+			want: "failed decoding STRING at offset 0: string exceeded the remaining message length: 134217729/134217724 bytes",
+		},
+		"past message end": {
+			in:     []byte{0x10, 0x00, 0x00, 0x00, 'a', 0},
+			offset: maxMsgSize - 16,
+			want:   "failed decoding STRING at offset 134217712: string exceeded the remaining message length: 17/12 bytes",
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			d := newDecoder(bytes.NewReader(tc.in))
+			d.offset = tc.offset
+
+			_, err := d.String()
+			if err == nil || err.Error() != tc.want {
+				t.Errorf("expected error %q got %v", tc.want, err)
+			}
+			if d.buf.Cap() > 64 {
+				t.Errorf("expected buffer to stay small, got %d bytes", d.buf.Cap())
+			}
+
+			d.Reset(bytes.NewReader(tc.in))
+			d.offset = tc.offset
+			err = d.SkipString()
+			if err == nil || err.Error() != tc.want {
+				t.Errorf("skip: expected error %q got %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	// The second string is cut after its length.
+	in := []byte{1, 0, 0, 0, 'a', 0, 0, 0, 5, 0, 0, 0, 'h', 'e'}
+	d := newDecoder(bytes.NewReader(in))
+	if _, err := d.String(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := d.String()
+	var decErr *DecodeError
+	if !errors.As(err, &decErr) {
+		t.Fatalf("expected DecodeError got %v", err)
+	}
+	if decErr.Offset != 8 || decErr.Op != "STRING" {
+		t.Errorf("expected STRING at offset 8 got %s at offset %d", decErr.Op, decErr.Offset)
+	}
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("expected EOF got %v", err)
+	}
+	wantMsg := "failed decoding STRING at offset 8: EOF"
+	if err.Error() != wantMsg {
+		t.Errorf("expected error %q got %q", wantMsg, err)
+	}
+}
+
+func TestDecodeLimit(t *testing.T) {
+	// The string takes 70 bytes including its length and the null byte.
+	tt := map[string]struct {
+		limit uint32
+		read  func(d *decoder) error
+		want  string
+	}{
+		"string": {
+			limit: 65,
+			read: func(d *decoder) error {
+				_, err := d.String()
+				return err
+			},
+			want: "failed decoding STRING at offset 0: read exceeded the message body: 66/61 bytes",
+		},
+		"string length": {
+			limit: 3,
+			read: func(d *decoder) error {
+				_, err := d.Uint32()
+				return err
+			},
+			want: "failed decoding UINT32 at offset 0: read exceeded the message body: 4/3 bytes",
+		},
+		"read": {
+			limit: 10,
+			read: func(d *decoder) error {
+				_, err := d.ReadN(11)
+				return err
+			},
+			want: "read exceeded the message body: 11/10 bytes",
+		},
+		"skip": {
+			limit: 10,
+			read: func(d *decoder) error {
+				return d.Skip(11)
+			},
+			want: "read exceeded the message body: 11/10 bytes",
+		},
+		"string array variant": {
+			limit: 10,
+			read: func(d *decoder) error {
+				var v Variant
+				return d.Value(newStringConverter(0), "as", &v)
+			},
+			want: "read exceeded the message body: 65/6 bytes",
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			d := newDecoder(bytes.NewReader(testString))
+			d.SetLimit(tc.limit)
+
+			err := tc.read(d)
+			if !errors.Is(err, ErrBodyOverrun) {
+				t.Fatalf("expected ErrBodyOverrun got %v", err)
+			}
+			if err.Error() != tc.want {
+				t.Errorf("expected error %q got %q", tc.want, err)
+			}
+		})
+	}
+
+	// The limit is removed by Reset.
+	d := newDecoder(bytes.NewReader(testString))
+	d.SetLimit(3)
+	d.Reset(bytes.NewReader(testString))
+	if _, err := d.String(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDecodeSkip(t *testing.T) {
+	d := newDecoder(bytes.NewReader(testString))
+	d.SetMaxBufferSize(8)
+
+	if err := d.Skip(uint32(len(testString))); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Offset() != uint32(len(testString)) {
+		t.Errorf("expected offset %d got %d", len(testString), d.Offset())
+	}
+	if d.buf.Cap() > 64 {
+		t.Errorf("expected buffer to stay small, got %d bytes", d.buf.Cap())
+	}
+}
+
+func TestDecodeSkipString(t *testing.T) {
+	// The string is followed by the padding and uint32 7.
+	in := append(append([]byte{}, testString...), 0, 0, 7, 0, 0, 0)
+	d := newDecoder(bytes.NewReader(in))
+	d.SetMaxBufferSize(8)
+
+	if err := d.SkipString(); err != nil {
+		t.Fatal(err)
+	}
+	if d.Offset() != uint32(len(testString)) {
+		t.Errorf("expected offset %d got %d", len(testString), d.Offset())
+	}
+
+	// The decoder must be positioned at the next value.
+	u, err := d.Uint32()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u != 7 {
+		t.Errorf("expected 7 got %d", u)
+	}
+}
+
+func TestDecodeSkipStruct(t *testing.T) {
+	tt := map[string]struct {
+		sig string
+		in  []byte
+	}{
+		"string and object path": {
+			sig: "(so)",
+			in:  []byte{1, 0, 0, 0, 'a', 0, 0, 0, 2, 0, 0, 0, '/', 'j', 0},
+		},
+		"uint64 after byte": {
+			sig: "(yt)",
+			in:  []byte{1, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0},
+		},
+		"nested array of structs": {
+			sig: "(sa(st))",
+			in: []byte{
+				1, 0, 0, 0, 'x', 0, 0, 0,
+				16, 0, 0, 0, 0, 0, 0, 0,
+				1, 0, 0, 0, 'z', 0, 0, 0, 7, 0, 0, 0, 0, 0, 0, 0,
+			},
+		},
+		"empty array": {
+			sig: "(uat)",
+			in:  []byte{5, 0, 0, 0, 0, 0, 0, 0},
+		},
+		"variant": {
+			sig: "(gv)",
+			in:  []byte{1, 's', 0, 1, 'u', 0, 0, 0, 3, 0, 0, 0},
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			d := newDecoder(bytes.NewReader(tc.in))
+			if err := d.SkipStruct(tc.sig); err != nil {
+				t.Fatal(err)
+			}
+
+			if d.Offset() != uint32(len(tc.in)) {
+				t.Errorf("expected offset %d got %d", len(tc.in), d.Offset())
+			}
+		})
+	}
+}
+
+func TestDecodeSkipStructError(t *testing.T) {
+	tt := map[string]struct {
+		sig    string
+		errMsg string
+	}{
+		"not a struct": {
+			sig:    "s",
+			errMsg: "not a struct signature: s",
+		},
+		"struct is not closed": {
+			sig:    "(s",
+			errMsg: "struct is not closed",
+		},
+		"multiple types": {
+			sig:    "(s)(s)",
+			errMsg: "struct signature must be a single complete type: (s)(s)",
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			in := []byte{1, 0, 0, 0, 'a', 0, 0, 0, 1, 0, 0, 0, 'b', 0}
+			d := newDecoder(bytes.NewReader(in))
+
+			err := d.SkipStruct(tc.sig)
+			if err == nil || tc.errMsg != err.Error() {
+				t.Fatalf("expected error %q got %q", tc.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestDecodeStruct(t *testing.T) {
+	type job struct {
+		ID                uint32
+		Unit, Type, State string
+		JobPath, UnitPath string
+	}
+	tt := map[string]struct {
+		sig    string
+		encode func(e *encoder)
+		dst    func(v *job) []any
+		want   job
+	}{
+		"(ss)": {
+			sig: "(ss)",
+			encode: func(e *encoder) {
+				e.String("dbus.service")
+				e.String("start")
+			},
+			dst: func(v *job) []any {
+				return []any{&v.Unit, &v.Type}
+			},
+			want: job{Unit: "dbus.service", Type: "start"},
+		},
+		"(usssoo)": {
+			sig: "(usssoo)",
+			encode: func(e *encoder) {
+				e.Uint32(42)
+				e.String("dbus.service")
+				e.String("start")
+				e.String("running")
+				e.String("/org/freedesktop/systemd1/job/42")
+				e.String("/org/freedesktop/systemd1/unit/dbus_2eservice")
+			},
+			dst: func(v *job) []any {
+				return []any{&v.ID, &v.Unit, &v.Type, &v.State, &v.JobPath, &v.UnitPath}
+			},
+			want: job{
+				ID:       42,
+				Unit:     "dbus.service",
+				Type:     "start",
+				State:    "running",
+				JobPath:  "/org/freedesktop/systemd1/job/42",
+				UnitPath: "/org/freedesktop/systemd1/unit/dbus_2eservice",
+			},
+		},
+		"(sus)": {
+			sig: "(sus)",
+			encode: func(e *encoder) {
+				e.String("dbus.service")
+				e.Uint32(2375)
+				e.String("/usr/bin/dbus-daemon")
+			},
+			dst: func(v *job) []any {
+				return []any{&v.Unit, &v.ID, &v.State}
+			},
+			want: job{Unit: "dbus.service", ID: 2375, State: "/usr/bin/dbus-daemon"},
+		},
+		"discarded fields": {
+			sig: "(usssoo)",
+			encode: func(e *encoder) {
+				e.Uint32(42)
+				e.String("dbus.service")
+				e.String("start")
+				e.String("running")
+				e.String("/org/freedesktop/systemd1/job/42")
+				e.String("/org/freedesktop/systemd1/unit/dbus_2eservice")
+			},
+			dst: func(v *job) []any {
+				return []any{&v.ID, nil, nil, &v.State, nil, nil}
+			},
+			want: job{ID: 42, State: "running"},
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			// The leading byte ensures the struct is aligned.
+			buf := &bytes.Buffer{}
+			e := newEncoder(buf)
+			e.Byte(1)
+			e.Align(8)
+			tc.encode(e)
+
+			d := newDecoder(bytes.NewReader(buf.Bytes()))
+			if _, err := d.Byte(); err != nil {
+				t.Fatal(err)
+			}
+			var got job
+			if err := d.Struct(newStringConverter(64), tc.sig, tc.dst(&got)); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error(diff)
+			}
+			if d.Offset() != uint32(buf.Len()) {
+				t.Errorf("expected offset %d got %d", buf.Len(), d.Offset())
+			}
+		})
+	}
+}
+
+func TestDecodeStructError(t *testing.T) {
+	var (
+		s string
+		u uint32
+	)
+	tt := map[string]struct {
+		sig    string
+		dst    []any
+		errMsg string
+	}{
+		"not a struct": {
+			sig:    "s",
+			dst:    []any{&s},
+			errMsg: "not a struct signature: s",
+		},
+		"fields mismatch": {
+			sig:    "(ss)",
+			dst:    []any{&s},
+			errMsg: "struct (ss) has 2 fields, got 1 destinations",
+		},
+		"type mismatch": {
+			sig:    "(ss)",
+			dst:    []any{&s, &u},
+			errMsg: "struct (ss) field 1: cannot decode type s into *uint32",
+		},
+		"container field": {
+			sig:    "(sv)",
+			dst:    []any{&s, &s},
+			errMsg: "struct (sv) field 1: unsupported type: v",
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			in := []byte{1, 0, 0, 0, 'a', 0, 0, 0, 1, 0, 0, 0, 'b', 0}
+			d := newDecoder(bytes.NewReader(in))
+
+			err := d.Struct(newStringConverter(64), tc.sig, tc.dst)
+			if err == nil || tc.errMsg != err.Error() {
+				t.Fatalf("expected error %q got %q", tc.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestDecodeUnixFDIndex(t *testing.T) {
+	// The body "hh" refers to the second and then the first descriptor.
+	d := newDecoder(bytes.NewReader([]byte{1, 0, 0, 0, 0, 0, 0, 0}))
+	// The descriptors aren't real since the decoder doesn't use them.
+	d.SetUnixFDs([]int{10, 11})
+
+	idx, err := d.UnixFDIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if idx != 1 {
+		t.Fatalf("expected index 1 got %d", idx)
+	}
+	fd, err := d.ClaimUnixFD(idx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fd != 11 {
+		t.Errorf("expected fd 11 got %d", fd)
+	}
+
+	wantErr := "unix fd not found at index 1"
+	if _, err = d.ClaimUnixFD(idx); err == nil || err.Error() != wantErr {
+		t.Errorf("expected error %q got %v", wantErr, err)
+	}
+
+	if fd, err = d.UnixFD(); err != nil {
+		t.Fatal(err)
+	}
+	if fd != 10 {
+		t.Errorf("expected fd 10 got %d", fd)
+	}
+}
+
+func TestDecodeVariant(t *testing.T) {
+	tt := map[string]struct {
+		in   []byte
+		want Variant
+	}{
+		"byte": {
+			in:   []byte{1, 'y', 0, 255},
+			want: Variant{Signature: "y", U: 255},
+		},
+		"int16": {
+			in:   []byte{1, 'n', 0, 0, 0xfe, 0xff},
+			want: Variant{Signature: "n", I: -2},
+		},
+		"uint16": {
+			in:   []byte{1, 'q', 0, 0, 0xfe, 0xff},
+			want: Variant{Signature: "q", U: 65534},
+		},
+		"int64": {
+			in:   []byte{1, 'x', 0, 0, 0, 0, 0, 0, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			want: Variant{Signature: "x", I: -2},
+		},
+		"double": {
+			in:   []byte{1, 'd', 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xf8, 0x3f},
+			want: Variant{Signature: "d", F: 1.5},
+		},
+		"signature": {
+			in:   []byte{1, 'g', 0, 2, 's', 's', 0},
+			want: Variant{Signature: "g", S: "ss"},
+		},
+		"string array": {
+			in:   []byte{2, 'a', 's', 0, 15, 0, 0, 0, 1, 0, 0, 0, 'a', 0, 0, 0, 2, 0, 0, 0, 'b', 'c', 0},
+			want: Variant{Signature: "as", Strings: []string{"a", "bc"}},
+		},
+		"string pair": {
+			in:   []byte{4, '(', 's', 's', ')', 0, 0, 0, 1, 0, 0, 0, 'x', 0, 0, 0, 1, 0, 0, 0, 'y', 0},
+			want: Variant{Signature: "(ss)", Strings: []string{"x", "y"}},
+		},
+		"raw struct array": {
+			in: []byte{5, 'a', '(', 's', 't', ')', 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 'z', 0, 0, 0, 7, 0, 0, 0, 0, 0, 0, 0},
+			want: Variant{Signature: "a(st)", Raw: &RawVariant{
+				Signature: "a(st)",
+				Bytes:     []byte{1, 0, 0, 0, 'z', 0, 0, 0, 7, 0, 0, 0, 0, 0, 0, 0},
+			}},
+		},
+		"raw nested array": {
+			in: []byte{3, 'a', 'a', 'i', 0, 0, 0, 0, 8, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0},
+			want: Variant{Signature: "aai", Raw: &RawVariant{
+				Signature: "aai",
+				Bytes:     []byte{4, 0, 0, 0, 1, 0, 0, 0},
+				Offset:    4,
+			}},
+		},
+		"raw empty array": {
+			in:   []byte{2, 'a', 'y', 0, 0, 0, 0, 0},
+			want: Variant{Signature: "ay", Raw: &RawVariant{Signature: "ay"}},
+		},
+		"raw struct": {
+			in: []byte{4, '(', 'u', 'o', ')', 0, 0, 0, 5, 0, 0, 0, 2, 0, 0, 0, '/', 'j', 0},
+			want: Variant{Signature: "(uo)", Raw: &RawVariant{
+				Signature: "(uo)",
+				Bytes:     []byte{5, 0, 0, 0, 2, 0, 0, 0, '/', 'j', 0},
+			}},
+		},
+		"raw struct with array": {
+			in: []byte{5, '(', 'b', 'a', 's', ')', 0, 0, 1, 0, 0, 0, 6, 0, 0, 0, 1, 0, 0, 0, 'q', 0},
+			want: Variant{Signature: "(bas)", Raw: &RawVariant{
+				Signature: "(bas)",
+				Bytes:     []byte{1, 0, 0, 0, 6, 0, 0, 0, 1, 0, 0, 0, 'q', 0},
+			}},
+		},
+		"raw variant": {
+			in: []byte{1, 'v', 0, 1, 't', 0, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0},
+			want: Variant{Signature: "v", Raw: &RawVariant{
+				Signature: "v",
+				Bytes:     []byte{1, 't', 0, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0},
+				Offset:    3,
+			}},
+		},
+	}
+
+	conv := newStringConverter(DefaultStringConverterSize)
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			d := newDecoder(bytes.NewReader(tc.in))
+
+			var got Variant
+			if err := d.Variant(conv, &got); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestDecodeVariantMaxArrayElements(t *testing.T) {
+	// The "as" variant contains 3 empty strings.
+	in := []byte{
+		2, 'a', 's', 0, 21, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0,
+	}
+	d := newDecoder(bytes.NewReader(in))
+	d.SetMaxArrayElements(3)
+
+	var v Variant
+	if err := d.Variant(newStringConverter(64), &v); err != nil {
+		t.Fatal(err)
+	}
+	if len(v.Strings) != 3 {
+		t.Fatalf("expected 3 strings got %d", len(v.Strings))
+	}
+
+	d = newDecoder(bytes.NewReader(in))
+	d.SetMaxArrayElements(2)
+	err := d.Variant(newStringConverter(64), &v)
+	if !errors.Is(err, ErrTooManyElements) {
+		t.Fatalf("expected ErrTooManyElements got %v", err)
+	}
+}
+
+func TestDecodeVariantError(t *testing.T) {
+	tt := map[string]struct {
+		in     []byte
+		errMsg string
+	}{
+		"empty signature": {
+			in:     []byte{0, 0},
+			errMsg: "empty variant signature",
+		},
+		"unknown type": {
+			in:     []byte{1, 'z', 0},
+			errMsg: "unknown type: z",
+		},
+		"struct is not closed": {
+			in:     []byte{2, '(', 'y', 0, 0, 0, 0, 0, 1},
+			errMsg: "struct is not closed",
+		},
+		"multiple types": {
+			in:     []byte{2, 'y', 'y', 0, 1, 2},
+			errMsg: "variant signature must be a single complete type: yy",
+		},
+		"string array too long": {
+			in:     []byte{2, 'a', 's', 0, 0xff, 0xff, 0xff, 0xff},
+			errMsg: "array exceeded the maximum length: 4294967295/67108864 bytes",
+		},
+	}
+
+	conv := newStringConverter(DefaultStringConverterSize)
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			d := newDecoder(bytes.NewReader(tc.in))
+
+			var v Variant
+			err := d.Variant(conv, &v)
+			if err == nil || tc.errMsg != err.Error() {
+				t.Fatalf("expected error %q got %q", tc.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestStringConverterReuse(t *testing.T) {
+	words := [][]byte{[]byte("fizz"), []byte("buzz"), []byte("quux")}
+	convert := func(conv *stringConverter) []string {
+		conv.Reset()
+		ss := make([]string, 0, len(words))
+		for _, w := range words {
+			ss = append(ss, conv.String(w))
+		}
+		return ss
+	}
+
+	// The third word doesn't fit into the 8-byte buffer.
+	tt := map[string]struct {
+		conv       *stringConverter
+		wantAllocs float64
+	}{
+		"new buffers":    {conv: newStringConverter(8), wantAllocs: 1},
+		"reused buffers": {conv: newReusableStringConverter(8), wantAllocs: 0},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			want := []string{"fizz", "buzz", "quux"}
+			if diff := cmp.Diff(want, convert(tc.conv)); diff != "" {
+				t.Error(diff)
+			}
+
+			// The results slice takes 1 alloc.
+			allocs := testing.AllocsPerRun(10, func() { convert(tc.conv) }) - 1
+			if allocs != tc.wantAllocs {
+				t.Errorf("expected %.0f allocs got %.0f", tc.wantAllocs, allocs)
+			}
+		})
+	}
+
+	// The strings are overwritten after Reset.
+	conv := newReusableStringConverter(8)
+	s := conv.String(words[0])
+	conv.Reset()
+	conv.String(words[1])
+	if s != "buzz" {
+		t.Errorf("expected overwritten string got %q", s)
+	}
+}
+
 var got []byte
 
 func BenchmarkDecodeString(b *testing.B) {
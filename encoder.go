@@ -5,6 +5,8 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"math"
 )
 
 // newEncoder creates a new D-Bus encoder.
@@ -24,11 +26,21 @@ type encoder struct {
 	// pad must always contain zeroes to add padding to dst.
 	pad [8]byte
 	// buf is a buffer that is used to encode integers.
-	buf [4]byte
+	buf [8]byte
 	// offset is a current position in the encoded message
 	// which is used solely to determine the alignment.
 	// The offset is limited by maxMessageSize.
 	offset uint32
+
+	// w receives the contents of dst once dst grows to flushSize,
+	// so the message doesn't have to be buffered entirely.
+	// The whole message is buffered in dst when w is nil.
+	w         io.Writer
+	flushSize int
+	// flushed is the number of bytes already written to w.
+	flushed uint32
+	// err is the first error that occurred when writing to w.
+	err error
 }
 
 // Reset resets the encoder to be writing into dst
@@ -36,6 +48,41 @@ type encoder struct {
 func (e *encoder) Reset(dst *bytes.Buffer) {
 	e.dst = dst
 	e.offset = 0
+	e.w = nil
+	e.flushed = 0
+	e.err = nil
+}
+
+// SetFlushWriter makes the encoder write the contents of dst to w
+// whenever dst grows to size bytes, see Flush.
+func (e *encoder) SetFlushWriter(w io.Writer, size int) {
+	e.w = w
+	e.flushSize = size
+}
+
+// Flush writes the encoded bytes left in dst to the flush writer
+// and returns the first error that occurred when writing to it.
+func (e *encoder) Flush() error {
+	if e.w != nil && e.dst.Len() > 0 {
+		e.flush()
+	}
+	return e.err
+}
+
+// flushIfFull writes dst to the flush writer once dst has grown to the flush size.
+func (e *encoder) flushIfFull() {
+	if e.w != nil && e.dst.Len() >= e.flushSize {
+		e.flush()
+	}
+}
+
+func (e *encoder) flush() {
+	// The rest of the message is discarded after a write error.
+	if e.err == nil {
+		_, e.err = e.w.Write(e.dst.Bytes())
+	}
+	e.flushed += uint32(e.dst.Len())
+	e.dst.Reset()
 }
 
 // Offset returns a current position in the encoded message.
@@ -60,6 +107,18 @@ func (e *encoder) Byte(b byte) {
 	e.offset++
 }
 
+// Uint16 encodes D-Bus UINT16.
+func (e *encoder) Uint16(u uint16) {
+	e.Align(u16size)
+
+	b := e.buf[:u16size]
+	e.order.PutUint16(b, u)
+	e.dst.Write(b)
+	// 2 bytes were written because uint16 takes 2 bytes.
+	e.offset += u16size
+	e.flushIfFull()
+}
+
 // Uint32 encodes D-Bus UINT32.
 func (e *encoder) Uint32(u uint32) {
 	e.Align(u32size)
@@ -69,15 +128,30 @@ func (e *encoder) Uint32(u uint32) {
 	e.dst.Write(b)
 	// 4 bytes were written because uint32 takes 4 bytes.
 	e.offset += u32size
+	e.flushIfFull()
+}
+
+// Uint64 encodes D-Bus UINT64.
+func (e *encoder) Uint64(u uint64) {
+	e.Align(u64size)
+
+	b := e.buf[:u64size]
+	e.order.PutUint64(b, u)
+	e.dst.Write(b)
+	// 8 bytes were written because uint64 takes 8 bytes.
+	e.offset += u64size
+	e.flushIfFull()
 }
 
 // Uint32At encodes UINT32 at the given offset.
 // This is useful when overwriting a header field such as FieldsLen
 // because it is not known in advance.
+// The bytes which were already flushed can't be overwritten.
 func (e *encoder) Uint32At(u, offset uint32) error {
-	if int(offset) >= e.dst.Len() {
-		return fmt.Errorf("offset is out of range: %d/%d", offset, e.dst.Len())
+	if offset < e.flushed || int(offset-e.flushed) >= e.dst.Len() {
+		return fmt.Errorf("offset is out of range: %d/%d", offset, int(e.flushed)+e.dst.Len())
 	}
+	offset -= e.flushed
 
 	b := e.buf[:u32size]
 	e.order.PutUint32(b, u)
@@ -98,6 +172,7 @@ func (e *encoder) String(s string) {
 	// Account for a null byte at the end of the string.
 	e.dst.WriteByte(0)
 	e.offset += uint32(strLen + 1)
+	e.flushIfFull()
 }
 
 // Signature encodes D-Bus SIGNATURE
@@ -111,12 +186,134 @@ func (e *encoder) Signature(s string) {
 	// Account for a null byte at the end of the string.
 	e.dst.WriteByte(0)
 	e.offset += uint32(strLen + 1)
+	e.flushIfFull()
+}
+
+// StringArray encodes D-Bus ARRAY of STRING.
+func (e *encoder) StringArray(ss []string) {
+	// The array length is in bytes,
+	// and it includes the padding between the strings.
+	// There is no padding before the first string,
+	// because the strings and the array length are 4-byte aligned.
+	var arrLen uint32
+	for i, s := range ss {
+		if i > 0 {
+			arrLen, _ = nextOffset(arrLen, u32size)
+		}
+		// Account for a string length and a null byte at the end of the string.
+		arrLen += u32size + uint32(len(s)) + 1
+	}
+	e.Uint32(arrLen)
+
+	for _, s := range ss {
+		e.String(s)
+	}
+}
+
+// checkVariant returns an error if the variant v can't be encoded,
+// i.e., its signature isn't a basic type or "as".
+// It should be called before encoding the message,
+// since Variant doesn't report errors.
+func checkVariant(v Variant) error {
+	switch v.Signature {
+	case "y", "b", "q", "n", "i", "u", "x", "t", "d", "s", "o", "as":
+		return nil
+	case "g":
+		if len(v.S) > maxSignatureLen {
+			return fmt.Errorf("signature exceeded the maximum length: %d/%d bytes", len(v.S), maxSignatureLen)
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported variant signature: %q", v.Signature)
+}
+
+// Variant encodes D-Bus VARIANT, i.e., the signature of the value
+// followed by the value taken from the field of v
+// corresponding to the signature, see checkVariant.
+func (e *encoder) Variant(v Variant) {
+	e.Signature(v.Signature)
+	e.Value(v)
+}
+
+// Value encodes the value taken from the field of v
+// corresponding to its signature without the signature itself,
+// e.g., a method argument, see checkVariant.
+func (e *encoder) Value(v Variant) {
+	switch v.Signature {
+	case "y":
+		e.Byte(byte(v.U))
+	case "b":
+		e.Uint32(boolToUint32(v.U != 0))
+	case "q":
+		e.Uint16(uint16(v.U))
+	case "n":
+		e.Uint16(uint16(v.I))
+	case "i":
+		e.Uint32(uint32(v.I))
+	case "u":
+		e.Uint32(uint32(v.U))
+	case "x":
+		e.Uint64(uint64(v.I))
+	case "t":
+		e.Uint64(v.U)
+	case "d":
+		e.Uint64(math.Float64bits(v.F))
+	case "s", "o":
+		e.String(v.S)
+	case "g":
+		e.Signature(v.S)
+	case "as":
+		e.StringArray(v.Strings)
+	}
+}
+
+// arrayMark marks the beginning of an ARRAY whose length
+// isn't known until its elements are encoded, see MarkArrayStart.
+type arrayMark struct {
+	// lenOffset is the offset of the array length placeholder.
+	lenOffset uint32
+	// start is the offset of the first element.
+	start uint32
+}
+
+// MarkArrayStart encodes a placeholder of D-Bus ARRAY length
+// followed by the padding to the alignment of the array elements,
+// e.g., 8 for the structs of "a(sv)".
+// The padding is added even if the array turns out to be empty.
+// Once the elements are encoded, FinishArray must be called
+// to overwrite the placeholder, so the nested arrays
+// such as "a(sa(sv))" are encoded with a mark per array.
+func (e *encoder) MarkArrayStart(elemAlign uint32) arrayMark {
+	e.Align(u32size)
+	m := arrayMark{lenOffset: e.offset}
+	e.Uint32(0)
+	e.Align(elemAlign)
+	m.start = e.offset
+	return m
+}
+
+// FinishArray overwrites the array length placeholder
+// with the number of bytes written since the first element,
+// i.e., the array length doesn't include the padding after the length.
+// In the stream mode (see SetFlushWriter) the placeholder can't be overwritten
+// once it has been flushed, so an error is returned instead.
+func (e *encoder) FinishArray(m arrayMark) error {
+	arrLen := e.offset - m.start
+	if arrLen > maxArrayLen {
+		return fmt.Errorf("array exceeded the maximum length: %d/%d bytes", arrLen, maxArrayLen)
+	}
+	if m.lenOffset < e.flushed {
+		return fmt.Errorf("array length is already flushed: offset %d/%d", m.lenOffset, e.flushed)
+	}
+	return e.Uint32At(arrLen, m.lenOffset)
 }
 
 // escapeBusLabel escapes a bus label such as a unit name.
 // Given a string s, all characters which are not ASCII alphanumerics
 // are replaced by C-style "\x2d" escapes.
 // If the first character is a numeric, it's also escaped.
+// The string is escaped byte by byte like systemd does,
+// e.g., "é" becomes "_c3_a9", and "_" is escaped as well.
 //
 // See https://github.com/systemd/systemd/blob/main/src/basic/bus-label.c.
 func escapeBusLabel(s string, buf *bytes.Buffer) {
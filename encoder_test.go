@@ -2,9 +2,250 @@ package systemd
 
 import (
 	"bytes"
+	"strings"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
+func TestEncodeStringArray(t *testing.T) {
+	tt := map[string]struct {
+		in   []string
+		want []byte
+	}{
+		"empty": {
+			in:   nil,
+			want: []byte{0, 0, 0, 0},
+		},
+		"padding": {
+			in:   []string{"a", "bc"},
+			want: []byte{15, 0, 0, 0, 1, 0, 0, 0, 'a', 0, 0, 0, 2, 0, 0, 0, 'b', 'c', 0},
+		},
+		"no padding": {
+			in:   []string{"abc", ""},
+			want: []byte{13, 0, 0, 0, 3, 0, 0, 0, 'a', 'b', 'c', 0, 0, 0, 0, 0, 0},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	enc := newEncoder(nil)
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			buf.Reset()
+			enc.Reset(buf)
+
+			enc.StringArray(tc.in)
+			if diff := cmp.Diff(tc.want, buf.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestEncodeFlush(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := &bytes.Buffer{}
+	enc := newEncoder(buf)
+	enc.SetFlushWriter(w, 8)
+
+	enc.Uint32(0)
+	enc.String("dbus.service")
+	enc.StringArray([]string{"a", "bc"})
+
+	if buf.Len() >= 8 {
+		t.Errorf("expected less than 8 buffered bytes got %d", buf.Len())
+	}
+	if err := enc.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []byte{
+		0, 0, 0, 0,
+		12, 0, 0, 0, 'd', 'b', 'u', 's', '.', 's', 'e', 'r', 'v', 'i', 'c', 'e', 0,
+		0, 0, 0,
+		15, 0, 0, 0, 1, 0, 0, 0, 'a', 0, 0, 0, 2, 0, 0, 0, 'b', 'c', 0,
+	}
+	if diff := cmp.Diff(want, w.Bytes()); diff != "" {
+		t.Error(diff)
+	}
+
+	// The flushed bytes can't be overwritten.
+	err := enc.Uint32At(1, 0)
+	errMsg := "offset is out of range: 0/43"
+	if err == nil || errMsg != err.Error() {
+		t.Fatalf("expected error %q got %q", errMsg, err)
+	}
+}
+
+func TestEncodeNestedArrays(t *testing.T) {
+	tt := map[string]struct {
+		sig    string
+		encode func(enc *encoder)
+		want   []byte
+	}{
+		"a(sa(sv))": {
+			sig: "a(sa(sv))",
+			encode: func(enc *encoder) {
+				outer := enc.MarkArrayStart(8)
+				enc.Align(8)
+				enc.String("X")
+				inner := enc.MarkArrayStart(8)
+				enc.Align(8)
+				enc.String("A")
+				enc.Signature("s")
+				enc.String("b")
+				if err := enc.FinishArray(inner); err != nil {
+					t.Fatal(err)
+				}
+				if err := enc.FinishArray(outer); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: []byte{
+				34, 0, 0, 0, 0, 0, 0, 0,
+				1, 0, 0, 0, 'X', 0, 0, 0,
+				18, 0, 0, 0, 0, 0, 0, 0,
+				1, 0, 0, 0, 'A', 0, 1, 's', 0, 0, 0, 0,
+				1, 0, 0, 0, 'b', 0,
+			},
+		},
+		"empty a(sv)": {
+			sig: "a(sv)",
+			encode: func(enc *encoder) {
+				m := enc.MarkArrayStart(8)
+				if err := enc.FinishArray(m); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: []byte{0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		"as after byte": {
+			sig: "yas",
+			encode: func(enc *encoder) {
+				enc.Byte(1)
+				m := enc.MarkArrayStart(4)
+				enc.String("a")
+				enc.String("bc")
+				if err := enc.FinishArray(m); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: []byte{1, 0, 0, 0, 15, 0, 0, 0, 1, 0, 0, 0, 'a', 0, 0, 0, 2, 0, 0, 0, 'b', 'c', 0},
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			enc := newEncoder(buf)
+			tc.encode(enc)
+
+			if diff := cmp.Diff(tc.want, buf.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+
+			// The decoder must consume exactly the encoded bytes.
+			d := newDecoder(bytes.NewReader(buf.Bytes()))
+			for sig := tc.sig; sig != ""; {
+				var err error
+				if sig, err = d.skipValue(sig, 0); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if d.Offset() != uint32(buf.Len()) {
+				t.Errorf("expected offset %d got %d", buf.Len(), d.Offset())
+			}
+		})
+	}
+}
+
+func TestEncodeVariant(t *testing.T) {
+	tt := map[string]Variant{
+		"byte":        {Signature: "y", U: 7},
+		"true":        {Signature: "b", U: 1},
+		"false":       {Signature: "b"},
+		"uint16":      {Signature: "q", U: 65535},
+		"int16":       {Signature: "n", I: -2},
+		"int32":       {Signature: "i", I: -15},
+		"uint32":      {Signature: "u", U: 2375},
+		"int64":       {Signature: "x", I: -1 << 40},
+		"uint64":      {Signature: "t", U: 1<<64 - 1},
+		"double":      {Signature: "d", F: 0.5},
+		"string":      {Signature: "s", S: "dbus.service"},
+		"object path": {Signature: "o", S: "/org/freedesktop/systemd1"},
+		"signature":   {Signature: "g", S: "a(sv)"},
+		"strings":     {Signature: "as", Strings: []string{"a", "bc"}},
+	}
+
+	for name, v := range tt {
+		t.Run(name, func(t *testing.T) {
+			if err := checkVariant(v); err != nil {
+				t.Fatal(err)
+			}
+
+			// The byte shifts the variant to check the alignment.
+			buf := &bytes.Buffer{}
+			enc := newEncoder(buf)
+			enc.Byte(1)
+			enc.Variant(v)
+
+			d := newDecoder(bytes.NewReader(buf.Bytes()))
+			if _, err := d.Byte(); err != nil {
+				t.Fatal(err)
+			}
+			var got Variant
+			if err := d.Variant(newStringConverter(32), &got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(v, got); diff != "" {
+				t.Error(diff)
+			}
+			if d.Offset() != uint32(buf.Len()) {
+				t.Errorf("expected offset %d got %d", buf.Len(), d.Offset())
+			}
+		})
+	}
+}
+
+func TestCheckVariant(t *testing.T) {
+	tt := map[string]struct {
+		v      Variant
+		errMsg string
+	}{
+		"empty":     {errMsg: `unsupported variant signature: ""`},
+		"struct":    {v: Variant{Signature: "(ss)"}, errMsg: `unsupported variant signature: "(ss)"`},
+		"variant":   {v: Variant{Signature: "v"}, errMsg: `unsupported variant signature: "v"`},
+		"signature": {v: Variant{Signature: "g", S: strings.Repeat("s", 256)}, errMsg: "signature exceeded the maximum length: 256/255 bytes"},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			err := checkVariant(tc.v)
+			if err == nil || err.Error() != tc.errMsg {
+				t.Errorf("expected error %q got %v", tc.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestEncodeArrayFlushed(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := &bytes.Buffer{}
+	enc := newEncoder(buf)
+	enc.SetFlushWriter(w, 8)
+
+	m := enc.MarkArrayStart(4)
+	enc.String("dbus.service")
+
+	// The array length placeholder was written before it could be overwritten.
+	err := enc.FinishArray(m)
+	errMsg := "array length is already flushed: offset 0/21"
+	if err == nil || errMsg != err.Error() {
+		t.Fatalf("expected error %q got %q", errMsg, err)
+	}
+}
+
 func TestEscapeBusLabel(t *testing.T) {
 	tt := map[string]string{
 		"":                                     "_",
@@ -15,6 +256,20 @@ func TestEscapeBusLabel(t *testing.T) {
 		"systemd-networkd-wait-online.service": "systemd_2dnetworkd_2dwait_2donline_2eservice",
 		"555":                                  "_3555",
 		"dev-ttyS8.device":                     "dev_2dttyS8_2edevice",
+		// The edge cases are checked against bus_label_escape from systemd,
+		// i.e., each byte is escaped separately with lowercase hex,
+		// and no escapes are special to it.
+		".service":            "_2eservice",
+		"a-.b.service":        "a_2d_2eb_2eservice",
+		"a..b.service":        "a_2e_2eb_2eservice",
+		"foo/bar.mount":       "foo_2fbar_2emount",
+		"_2eservice":          "_5f2eservice",
+		"foo\\x2dbar.service": "foo_5cx2dbar_2eservice",
+		"0day.service":        "_30day_2eservice",
+		"caf\u00e9.service":   "caf_c3_a9_2eservice",
+		"getty@tty1.service":  "getty_40tty1_2eservice",
+		"-.mount":             "_2d_2emount",
+		"-.slice":             "_2d_2eslice",
 	}
 
 	buf := &bytes.Buffer{}
@@ -0,0 +1,119 @@
+package systemd
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNoSuchUnit indicates that the unit isn't loaded or doesn't exist.
+	ErrNoSuchUnit = errors.New("no such unit")
+	// ErrUnitMasked indicates that the unit is masked
+	// and therefore can't be started.
+	ErrUnitMasked = errors.New("unit masked")
+	// ErrUnknownProperty indicates that the requested property doesn't exist,
+	// e.g., the running systemd version doesn't have it yet,
+	// or the unit doesn't implement the interface of the property.
+	ErrUnknownProperty = errors.New("unknown property")
+	// ErrUnknownMethod indicates that the called method doesn't exist,
+	// e.g., the running systemd version doesn't have it yet.
+	ErrUnknownMethod = errors.New("unknown method")
+	// ErrBodyOverrun indicates that decoding a value
+	// would read past the end of the message body,
+	// i.e., the body is shorter than its signature requires.
+	ErrBodyOverrun = errors.New("read exceeded the message body")
+	// ErrTruncatedBody indicates that an array in the message body
+	// ends in the middle of its last element,
+	// e.g., the final unit of ListUnits reply is incomplete.
+	ErrTruncatedBody = errors.New("message body is truncated")
+	// ErrTooManyElements indicates that an array in the message body
+	// has more elements than allowed, see WithMaxArrayElements.
+	ErrTooManyElements = errors.New("too many array elements")
+)
+
+// dbusErrors maps D-Bus error names to the package's sentinel errors.
+var dbusErrors = map[string]error{
+	"org.freedesktop.systemd1.NoSuchUnit":        ErrNoSuchUnit,
+	"org.freedesktop.systemd1.UnitMasked":        ErrUnitMasked,
+	"org.freedesktop.DBus.Error.UnknownProperty": ErrUnknownProperty,
+	"org.freedesktop.DBus.Error.UnknownMethod":   ErrUnknownMethod,
+}
+
+// DBusError represents an error reply from D-Bus,
+// e.g., org.freedesktop.DBus.Error.UnknownProperty.
+// It matches the corresponding sentinel error such as ErrUnknownProperty
+// when using errors.Is.
+type DBusError struct {
+	// Name is the error name, e.g., "org.freedesktop.DBus.Error.AccessDenied".
+	Name string
+	// Message is a human readable error message.
+	Message string
+}
+
+func (e *DBusError) Error() string {
+	return e.Message
+}
+
+// Is reports whether the D-Bus error corresponds to the target sentinel error.
+func (e *DBusError) Is(target error) bool {
+	err, ok := dbusErrors[e.Name]
+	return ok && err == target
+}
+
+// AuthError represents a failed authentication with the message bus,
+// e.g., when the server rejected the EXTERNAL mechanism
+// because the Client's uid didn't match the peer credentials.
+type AuthError struct {
+	// Mechanism is the auth mechanism the server didn't accept, e.g., "EXTERNAL".
+	Mechanism string
+	// ServerReply is the server's reply line without the trailing \r\n,
+	// e.g., "REJECTED EXTERNAL".
+	ServerReply string
+	// Err is the Client's error which failed the mechanism if any,
+	// e.g., when the cookie of DBUS_COOKIE_SHA1 mechanism wasn't found.
+	Err error
+}
+
+func (e *AuthError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("auth %s: %v", e.Mechanism, e.Err)
+	}
+	return fmt.Sprintf("auth %s: expected OK, got %q", e.Mechanism, e.ServerReply)
+}
+
+// Unwrap returns the Client's error which failed the mechanism.
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError represents a failure to decode a value of a D-Bus message,
+// e.g., a STRING whose length exceeds the message body.
+// The offset helps to locate the malformed value in the message.
+type DecodeError struct {
+	// Offset is the position of the value in the message
+	// counting from the start of the header.
+	Offset uint32
+	// Op is the D-Bus type of the value, e.g., "STRING".
+	Op string
+	// Err is the underlying error, e.g., io.EOF or ErrBodyOverrun.
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("failed decoding %s at offset %d: %v", e.Op, e.Offset, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// newDecodeError wraps err into DecodeError.
+// The error of a nested value is replaced with the outer one,
+// e.g., a failed STRING length is reported as STRING rather than UINT32.
+func newDecodeError(op string, offset uint32, err error) error {
+	if de, ok := err.(*DecodeError); ok {
+		err = de.Err
+	}
+	return &DecodeError{Offset: offset, Op: op, Err: err}
+}
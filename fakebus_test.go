@@ -0,0 +1,550 @@
+package systemd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeBus is a fake message bus which plays systemd over a Unix socket,
+// so the Client can be tested end to end without a real bus or root:
+// New, EXTERNAL auth, Hello, a method call, and decoding of the reply.
+//
+// It answers Hello, Ping, AddMatch, and RemoveMatch on its own,
+// and replies to other method calls with the handlers registered by a method name.
+// A call without a handler gets org.freedesktop.DBus.Error.UnknownMethod error.
+type fakeBus struct {
+	// Addr is the bus address to connect to, see WithAddress.
+	Addr string
+
+	mu       sync.Mutex
+	handlers map[string]fakeHandler
+	calls    []fakeCall
+	// authReply is the server's reply to AUTH command.
+	authReply string
+	// nConns is a number of the accepted connections
+	// which is used to name the connections, e.g., ":1.1".
+	nConns int
+}
+
+// fakeCall is a method call received by fakeBus.
+type fakeCall struct {
+	Path      string
+	Interface string
+	Member    string
+	Signature string
+	// Destination is the bus name the call is sent to.
+	Destination string
+	Serial      uint32
+	Flags       byte
+	// Args are the leading string arguments of the call
+	// (STRING, OBJECT_PATH, or SIGNATURE) decoded from the body,
+	// e.g., the interface and the property name of Get method.
+	Args []string
+	// Body is the little-endian encoded body of the call
+	// to inspect the arguments other than strings.
+	Body []byte
+}
+
+// fakeReply is a reply to a method call.
+// When ErrName is set, an error reply is sent
+// with ErrMessage as its argument.
+type fakeReply struct {
+	// Signature is the signature of the reply body, e.g., "a(ssssssouso)".
+	Signature string
+	// Body encodes the reply body if set.
+	Body       func(enc *encoder)
+	ErrName    string
+	ErrMessage string
+}
+
+// fakeHandler returns a reply to the method call.
+type fakeHandler func(call fakeCall) fakeReply
+
+// newFakeBus starts a fake bus listening on a Unix socket in a temp dir.
+// The bus and its connections are closed when the test finishes.
+func newFakeBus(t testing.TB) *fakeBus {
+	path := filepath.Join(t.TempDir(), "bus")
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := fakeBus{
+		Addr:      "unix:path=" + path,
+		handlers:  make(map[string]fakeHandler),
+		authReply: "OK bde8d2222a9e966420ee8c1a63e972b4",
+	}
+
+	var wg sync.WaitGroup
+	t.Cleanup(func() {
+		l.Close()
+		wg.Wait()
+	})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { conn.Close() })
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				b.Serve(conn)
+			}()
+		}
+	}()
+
+	return &b
+}
+
+// Handle registers the handler of the method, e.g., "ListUnits".
+func (b *fakeBus) Handle(member string, h fakeHandler) {
+	b.mu.Lock()
+	b.handlers[member] = h
+	b.mu.Unlock()
+}
+
+// Reply registers the reply which is sent to every call of the method.
+func (b *fakeBus) Reply(member string, r fakeReply) {
+	b.Handle(member, func(fakeCall) fakeReply { return r })
+}
+
+// RejectAuth makes the bus reject the EXTERNAL auth.
+func (b *fakeBus) RejectAuth() {
+	b.mu.Lock()
+	b.authReply = "REJECTED EXTERNAL"
+	b.mu.Unlock()
+}
+
+// Calls returns the method calls received so far
+// including the ones the bus answers on its own such as Hello.
+func (b *fakeBus) Calls() []fakeCall {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]fakeCall(nil), b.calls...)
+}
+
+// Serve performs the auth and answers the method calls received over conn
+// until the connection is closed,
+// e.g., one end of net.Pipe can be served while the other is passed to NewWithConn.
+func (b *fakeBus) Serve(conn net.Conn) {
+	defer conn.Close()
+
+	b.mu.Lock()
+	b.nConns++
+	connName := fmt.Sprintf(":1.%d", b.nConns)
+	b.mu.Unlock()
+
+	r := bufio.NewReader(conn)
+	if !b.auth(r, conn) {
+		return
+	}
+
+	var (
+		dec    = newDecoder(r)
+		conv   = newStringConverter(DefaultStringConverterSize)
+		h      header
+		body   []byte
+		serial uint32
+		err    error
+	)
+	for {
+		dec.Reset(r)
+		if err = decodeHeader(dec, conv, &h, false); err != nil {
+			return
+		}
+		if body, err = dec.ReadN(h.BodyLen); err != nil {
+			return
+		}
+		if h.Type != msgTypeMethodCall {
+			continue
+		}
+
+		call := fakeCall{
+			Path:        h.stringField(fieldPath),
+			Interface:   h.stringField(fieldInterface),
+			Member:      h.stringField(fieldMember),
+			Signature:   h.stringField(fieldSignature),
+			Destination: h.stringField(fieldDestination),
+			Serial:      h.Serial,
+			Flags:       h.Flags,
+			Body:        append([]byte(nil), body...),
+		}
+		call.Args = fakeStringArgs(call.Signature, body)
+		reply := b.handle(call, connName)
+
+		if h.Flags&flagNoReplyExpected != 0 {
+			continue
+		}
+		serial++
+		if _, err = conn.Write(encodeFakeReply(serial, h.Serial, connName, reply)); err != nil {
+			return
+		}
+	}
+}
+
+// auth performs EXTERNAL auth, and reports whether it succeeded.
+func (b *fakeBus) auth(r *bufio.Reader, w io.Writer) bool {
+	// The client sends a null byte first.
+	if _, err := r.ReadByte(); err != nil {
+		return false
+	}
+
+	b.mu.Lock()
+	authReply := b.authReply
+	b.mu.Unlock()
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return false
+		}
+
+		switch cmd := strings.TrimRight(line, "\r\n"); {
+		case strings.HasPrefix(cmd, "AUTH EXTERNAL"):
+			io.WriteString(w, authReply+"\r\n")
+			if !strings.HasPrefix(authReply, "OK") {
+				return false
+			}
+		case cmd == "NEGOTIATE_UNIX_FD":
+			io.WriteString(w, "AGREE_UNIX_FD\r\n")
+		case cmd == "BEGIN":
+			return true
+		default:
+			io.WriteString(w, "ERROR\r\n")
+		}
+	}
+}
+
+// handle records the call and returns its reply.
+func (b *fakeBus) handle(call fakeCall, connName string) fakeReply {
+	b.mu.Lock()
+	b.calls = append(b.calls, call)
+	h, ok := b.handlers[call.Member]
+	b.mu.Unlock()
+
+	if ok {
+		return h(call)
+	}
+
+	switch call.Member {
+	case "Hello":
+		return fakeReply{
+			Signature: "s",
+			Body: func(enc *encoder) {
+				enc.String(connName)
+			},
+		}
+	case "Ping", "AddMatch", "RemoveMatch":
+		return fakeReply{}
+	default:
+		return fakeError(
+			"org.freedesktop.DBus.Error.UnknownMethod",
+			fmt.Sprintf("Unknown method %s or interface %s.", call.Member, call.Interface),
+		)
+	}
+}
+
+// fakeStringArgs decodes the leading string arguments of a little-endian body
+// since the Client encodes messages in little-endian.
+func fakeStringArgs(sig string, body []byte) []string {
+	var (
+		args []string
+		dec  = newDecoder(bytes.NewReader(body))
+	)
+	for i := 0; i < len(sig); i++ {
+		var (
+			s   []byte
+			err error
+		)
+		switch sig[i] {
+		case typeString, typeObjectPath:
+			s, err = dec.String()
+		case typeSignature:
+			s, err = dec.Signature()
+		default:
+			return args
+		}
+		if err != nil {
+			return args
+		}
+		args = append(args, string(s))
+	}
+
+	return args
+}
+
+// encodeFakeReply encodes the reply to the method call with the given serial.
+func encodeFakeReply(serial, replySerial uint32, dest string, r fakeReply) []byte {
+	var body bytes.Buffer
+	// The body starts on an 8-byte boundary,
+	// so it's encoded separately to learn its length.
+	enc := newEncoder(&body)
+	sig := r.Signature
+	if r.ErrName != "" {
+		sig = "s"
+		enc.String(r.ErrMessage)
+	} else if r.Body != nil {
+		r.Body(enc)
+	}
+
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodReply,
+		Flags:     flagNoReplyExpected,
+		Proto:     protoVersion,
+		BodyLen:   uint32(body.Len()),
+		Serial:    serial,
+		Fields: []headerField{
+			{Signature: "u", U: uint64(replySerial), Code: fieldReplySerial},
+			{Signature: "s", S: dest, Code: fieldDestination},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldSender},
+		},
+	}
+	if r.ErrName != "" {
+		h.Type = msgTypeError
+		h.Fields = append(h.Fields, headerField{Signature: "s", S: r.ErrName, Code: fieldErrorName})
+	}
+	if sig != "" {
+		h.Fields = append(h.Fields, headerField{Signature: "g", S: sig, Code: fieldSignature})
+	}
+
+	var msg bytes.Buffer
+	if err := encodeHeader(newEncoder(&msg), &h); err != nil {
+		panic(err)
+	}
+	msg.Write(body.Bytes())
+
+	return msg.Bytes()
+}
+
+// fakeError returns an error reply, e.g., org.freedesktop.systemd1.NoSuchUnit.
+func fakeError(name, message string) fakeReply {
+	return fakeReply{
+		ErrName:    name,
+		ErrMessage: message,
+	}
+}
+
+// fakeListUnits returns a reply of ListUnits method.
+func fakeListUnits(units ...Unit) fakeReply {
+	return fakeReply{
+		Signature: "a(ssssssouso)",
+		Body: func(enc *encoder) {
+			arr := enc.MarkArrayStart(8)
+			for _, u := range units {
+				enc.Align(8)
+				enc.String(u.Name)
+				enc.String(u.Description)
+				enc.String(u.LoadState)
+				enc.String(u.ActiveState)
+				enc.String(u.SubState)
+				enc.String(u.Followed)
+				enc.String(u.Path)
+				enc.Uint32(u.JobID)
+				enc.String(u.JobType)
+				enc.String(u.JobPath)
+			}
+			enc.FinishArray(arr)
+		},
+	}
+}
+
+// fakeUint32Property returns a reply of org.freedesktop.DBus.Properties.Get method
+// with UINT32 value, e.g., MainPID.
+func fakeUint32Property(v uint32) fakeReply {
+	return fakeReply{
+		Signature: "v",
+		Body: func(enc *encoder) {
+			enc.Signature("u")
+			enc.Uint32(v)
+		},
+	}
+}
+
+// fakeObjectPath returns a reply with the object path,
+// e.g., to GetUnit method.
+func fakeObjectPath(path string) fakeReply {
+	return fakeReply{
+		Signature: "o",
+		Body: func(enc *encoder) {
+			enc.String(path)
+		},
+	}
+}
+
+// fakeStringProperties returns a reply of org.freedesktop.DBus.Properties.GetAll method
+// with STRING properties given as name and value pairs.
+func fakeStringProperties(nameValues ...string) fakeReply {
+	return fakeReply{
+		Signature: "a{sv}",
+		Body: func(enc *encoder) {
+			arr := enc.MarkArrayStart(8)
+			for i := 0; i+1 < len(nameValues); i += 2 {
+				enc.Align(8)
+				enc.String(nameValues[i])
+				enc.Signature("s")
+				enc.String(nameValues[i+1])
+			}
+			enc.FinishArray(arr)
+		},
+	}
+}
+
+// fakeUnitFileChanges returns a reply of EnableUnitFiles method
+// if the install info is given, otherwise of DisableUnitFiles method.
+func fakeUnitFileChanges(installInfo *bool, changes ...UnitFileChange) fakeReply {
+	sig := "a(sss)"
+	if installInfo != nil {
+		sig = "ba(sss)"
+	}
+	return fakeReply{
+		Signature: sig,
+		Body: func(enc *encoder) {
+			if installInfo != nil {
+				enc.Uint32(boolToUint32(*installInfo))
+			}
+			arr := enc.MarkArrayStart(8)
+			for _, ch := range changes {
+				enc.Align(8)
+				enc.String(ch.Type)
+				enc.String(ch.Filename)
+				enc.String(ch.Destination)
+			}
+			enc.FinishArray(arr)
+		},
+	}
+}
+
+func TestFakeBusListUnits(t *testing.T) {
+	b := newFakeBus(t)
+	b.Reply("ListUnits", fakeListUnits(expectedServices...))
+
+	c, err := New(WithAddress(b.Addr), WithStrictDecoding())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	var got []Unit
+	err = c.ListUnits(nil, func(u *Unit) {
+		got = append(got, *u)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expectedServices, got); diff != "" {
+		t.Error(diff)
+	}
+
+	var members []string
+	for _, call := range b.Calls() {
+		members = append(members, call.Member)
+	}
+	if diff := cmp.Diff([]string{"Hello", "ListUnits"}, members); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestFakeBusMainPID(t *testing.T) {
+	b := newFakeBus(t)
+	b.Handle("Get", func(call fakeCall) fakeReply {
+		if call.Path != "/org/freedesktop/systemd1/unit/dbus_2eservice" {
+			return fakeError("org.freedesktop.systemd1.NoSuchUnit", "Unit "+call.Path+" not loaded.")
+		}
+		if call.Args[1] != "MainPID" {
+			return fakeError("org.freedesktop.DBus.Error.UnknownProperty", "Unknown property "+call.Args[1])
+		}
+		return fakeUint32Property(2375)
+	})
+
+	c, err := New(WithAddress(b.Addr), WithSerialCheck())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	pid, err := c.MainPID("dbus.service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pid != 2375 {
+		t.Errorf("expected pid 2375 got %d", pid)
+	}
+
+	_, err = c.MainPID("nonexistent.service")
+	if !errors.Is(err, ErrNoSuchUnit) {
+		t.Fatalf("expected ErrNoSuchUnit got %v", err)
+	}
+	// The connection is usable after the error reply.
+	if _, err = c.MainPID("dbus.service"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFakeBusUnknownMethod(t *testing.T) {
+	b := newFakeBus(t)
+
+	c, err := New(WithAddress(b.Addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	_, err = c.NFailedUnits()
+	if !errors.Is(err, ErrUnknownMethod) {
+		t.Fatalf("expected ErrUnknownMethod got %v", err)
+	}
+}
+
+func TestFakeBusAuthRejected(t *testing.T) {
+	b := newFakeBus(t)
+	b.RejectAuth()
+
+	_, err := New(WithAddress(b.Addr))
+	var authErr *AuthError
+	if !errors.As(err, &authErr) {
+		t.Fatalf("expected AuthError got %v", err)
+	}
+	if authErr.ServerReply != "REJECTED EXTERNAL" {
+		t.Errorf("expected REJECTED EXTERNAL got %q", authErr.ServerReply)
+	}
+}
+
+func TestFakeBusPipe(t *testing.T) {
+	b := newFakeBus(t)
+	b.Reply("Get", fakeUint32Property(2375))
+
+	client, server := net.Pipe()
+	go b.Serve(server)
+
+	c, err := NewWithConn(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	pid, err := c.MainPID("dbus.service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pid != 2375 {
+		t.Errorf("expected pid 2375 got %d", pid)
+	}
+}
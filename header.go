@@ -19,6 +19,22 @@ const (
 	msgTypeSignal
 )
 
+// Message flags that can appear in the third byte of the header.
+const (
+	// flagNoReplyExpected indicates that this message does not expect
+	// method return replies or error replies,
+	// even if it is of a type that can have a reply;
+	// the reply should be omitted.
+	flagNoReplyExpected byte = 1 << iota
+	// flagNoAutoStart indicates that the bus must not launch an owner
+	// for the destination name in response to this message.
+	flagNoAutoStart
+	// flagAllowInteractiveAuthorization indicates that the caller is prepared
+	// to wait for interactive authorization,
+	// which might take a considerable time to complete.
+	flagAllowInteractiveAuthorization
+)
+
 // header represents a message header.
 type header struct {
 	// ByteOrder is an endianness flag;
@@ -51,6 +67,20 @@ type header struct {
 	Fields []headerField
 }
 
+// protoVersion is the major protocol version of D-Bus
+// which this package implements.
+const protoVersion = 1
+
+// checkProto returns an error if the message was sent
+// with a protocol version other than protoVersion,
+// since its layout might differ from what the decoder expects.
+func (h *header) checkProto() error {
+	if h.Proto != protoVersion {
+		return fmt.Errorf("unsupported D-Bus protocol version: %d", h.Proto)
+	}
+	return nil
+}
+
 const (
 	littleEndian = 'l'
 	bigEndian    = 'B'
@@ -83,11 +113,18 @@ const (
 	// maxMsgSize is the maximum length of a message (128 MiB),
 	// including header, header alignment padding, and body.
 	maxMsgSize = 134217728
+	// maxArrayLen is the maximum length of an array in bytes (64 MiB).
+	maxArrayLen = 67108864
+	// maxSignatureLen is the maximum length of a signature
+	// because its length is encoded as a single byte.
+	maxSignatureLen = 255
 )
 
 // decodeHeader decodes a message header from conn into h.
 // The string converter conv helps to reduce allocs when decoding header fields.
-// A caller can ignore the header fields with the skipFields flag.
+// A caller can ignore the header fields with the skipFields flag,
+// though the fields of an error reply are always decoded
+// because they contain the error name.
 // Note, all fields of h must be overwritten because h is reused.
 //
 // The signature of the header is "yyyyuua(yv)" which is
@@ -121,14 +158,23 @@ func decodeHeader(dec *decoder, conv *stringConverter, h *header, skipFields boo
 	if h.BodyLen > maxMsgSize {
 		return fmt.Errorf("message exceeded the maximum length: %d/%d bytes", h.BodyLen, maxMsgSize)
 	}
+	if h.FieldsLen > maxMsgSize {
+		return fmt.Errorf("header fields exceeded the maximum length: %d/%d bytes", h.FieldsLen, maxMsgSize)
+	}
+	// The lengths are summed as uint64, so they can't overflow.
+	if msgLen := uint64(msgPrologueSize) + uint64(h.FieldsLen) + uint64(h.BodyLen); msgLen > maxMsgSize {
+		return fmt.Errorf("message exceeded the maximum length: %d/%d bytes", msgLen, maxMsgSize)
+	}
 
 	// Clean the fields from a previous header use.
 	h.Fields = h.Fields[:0]
 	// Read the header fields where the body signature is stored.
 	// A caller might already know the signature from the spec
 	// and choose not to decode the fields as an optimization.
-	if skipFields {
-		if _, err = dec.ReadN(h.FieldsLen); err != nil {
+	// The reply serial is kept though,
+	// so the reply can be matched to its method call.
+	if skipFields && h.Type != msgTypeError {
+		if err = skipHeaderFields(dec, h); err != nil {
 			return fmt.Errorf("message header: %w", err)
 		}
 	} else {
@@ -138,11 +184,16 @@ func decodeHeader(dec *decoder, conv *stringConverter, h *header, skipFields boo
 		)
 		for dec.offset < hdrArrEnd {
 			if f, err = decodeHeaderField(dec, conv); err != nil {
-				break
+				return fmt.Errorf("header field: %w", err)
 			}
 
 			h.Fields = append(h.Fields, f)
 		}
+		// The last field mustn't spill over the header fields array,
+		// otherwise the body would be read from a wrong offset.
+		if dec.offset > hdrArrEnd {
+			return fmt.Errorf("header fields exceeded their length: %d/%d bytes", dec.offset-msgPrologueSize, h.FieldsLen)
+		}
 	}
 
 	// The length of the header must be a multiple of 8,
@@ -157,6 +208,77 @@ func decodeHeader(dec *decoder, conv *stringConverter, h *header, skipFields boo
 	return nil
 }
 
+// skipHeaderFields discards the header fields except for the reply serial
+// which is stored in the header without allocations.
+// The fields are read at once and scanned in place,
+// since the fields array starts at 8-byte aligned offset 16,
+// the alignment is the same relative to the array.
+//
+// The fields which don't fit into the decoder's max buffer size
+// are discarded in chunks without looking for the reply serial.
+func skipHeaderFields(dec *decoder, h *header) error {
+	if dec.maxBufSize > 0 && h.FieldsLen > uint32(dec.maxBufSize) {
+		return dec.Skip(h.FieldsLen)
+	}
+
+	b, err := dec.ReadN(h.FieldsLen)
+	if err != nil {
+		return err
+	}
+
+	var (
+		order = h.Order()
+		n     = uint32(len(b))
+		i     uint32
+	)
+	for i < n {
+		// Since "(yv)" struct is being decoded, an alignment must be discarded.
+		i, _ = nextOffset(i, 8)
+		// The field code is followed by a single-type signature,
+		// e.g., 5, 1, 'u', 0 for the reply serial.
+		if i+4 > n {
+			return fmt.Errorf("header fields exceeded their length: %d/%d bytes", i+4, n)
+		}
+		code, sigLen, t := b[i], b[i+1], b[i+2]
+		if sigLen != 1 {
+			return fmt.Errorf("container type is not supported: %d bytes signature", sigLen)
+		}
+		i += 4
+
+		switch t {
+		case typeUint32, typeString, typeObjectPath:
+			i, _ = nextOffset(i, u32size)
+			if i+u32size > n {
+				return fmt.Errorf("header fields exceeded their length: %d/%d bytes", i+u32size, n)
+			}
+			u := order.Uint32(b[i:])
+			i += u32size
+			if t != typeUint32 {
+				// Account for a null byte at the end of the string.
+				// The sum can't overflow since it's compared with n below.
+				i += u + 1
+				if u >= n {
+					i = n + 1
+				}
+			} else if code == fieldReplySerial {
+				h.Fields = append(h.Fields, headerField{Signature: "u", U: uint64(u), Code: code})
+			}
+		case typeSignature:
+			if i >= n {
+				return fmt.Errorf("header fields exceeded their length: %d/%d bytes", i+1, n)
+			}
+			i += 1 + uint32(b[i]) + 1
+		default:
+			return fmt.Errorf("unsupported header field type: %c", t)
+		}
+	}
+	if i > n {
+		return fmt.Errorf("header fields exceeded their length: %d/%d bytes", i, n)
+	}
+
+	return nil
+}
+
 // Header fields.
 const (
 	// fieldPath is the object to send a call to,
@@ -195,18 +317,61 @@ const (
 	// i.e., the body must be 0-length.
 	// This header field is controlled by the message sender.
 	fieldSignature
+	// fieldUnixFDs is the number of Unix file descriptors that accompany the message.
+	// If omitted, it is assumed that there are no Unix file descriptors accompanying the message.
+	// The actual file descriptors need to be transferred out-of-band,
+	// e.g., as SCM_RIGHTS ancillary data of a Unix domain socket.
+	fieldUnixFDs
 )
 
 // D-Bus types,
 // see https://dbus.freedesktop.org/doc/dbus-specification.html#id-1.3.8.
 const (
 	typeByte       = 'y'
+	typeBoolean    = 'b'
+	typeInt16      = 'n'
+	typeUint16     = 'q'
+	typeInt32      = 'i'
 	typeUint32     = 'u'
+	typeInt64      = 'x'
+	typeUint64     = 't'
+	typeDouble     = 'd'
 	typeString     = 's'
 	typeObjectPath = 'o'
 	typeSignature  = 'g'
+	typeUnixFD     = 'h'
+	typeArray      = 'a'
+	typeVariant    = 'v'
 )
 
+// errorName returns the name of the error from the header fields,
+// e.g., "org.freedesktop.DBus.Error.UnknownProperty".
+func (h *header) errorName() string {
+	return h.stringField(fieldErrorName)
+}
+
+// replySerial returns the serial of the message this message is a reply to,
+// or zero if the field is absent.
+func (h *header) replySerial() uint32 {
+	for _, f := range h.Fields {
+		if f.Code == fieldReplySerial {
+			return uint32(f.U)
+		}
+	}
+	return 0
+}
+
+// stringField returns a value of the header field with the given code,
+// e.g., fieldMember, or an empty string if the field is absent.
+func (h *header) stringField(code byte) string {
+	for _, f := range h.Fields {
+		if f.Code == code {
+			return f.S
+		}
+	}
+	return ""
+}
+
 // headerField represents a header field.
 // The array at the end of the header contains header fields,
 // where each field is a 1-byte field code followed by a field value.
@@ -325,6 +490,9 @@ func encodeHeader(enc *encoder, h *header) error {
 }
 
 // encodeHeaderField encodes a header field.
+// Note, f.Signature is the type of the variant value which must be a basic type,
+// whereas the value of the SIGNATURE field f.S is the body signature
+// which can have many types, e.g., "a(ssssssouso)".
 func encodeHeaderField(e *encoder, f headerField) error {
 	// Container types are not supported yet.
 	// Because there is no need in the scope of this library.
@@ -348,6 +516,10 @@ func encodeHeaderField(e *encoder, f headerField) error {
 	case typeString, typeObjectPath:
 		e.String(f.S)
 	case typeSignature:
+		// The signature would be silently truncated by the single-byte length.
+		if len(f.S) > maxSignatureLen {
+			return fmt.Errorf("signature exceeded the maximum length: %d/%d bytes", len(f.S), maxSignatureLen)
+		}
 		e.Signature(f.S)
 	default:
 		return fmt.Errorf("unknown type: %s", f.Signature)
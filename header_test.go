@@ -2,7 +2,10 @@ package systemd
 
 import (
 	"bytes"
+	"encoding/binary"
 	"io"
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -200,6 +203,59 @@ func TestDecodeHeader(t *testing.T) {
 	}
 }
 
+func TestDecodeHeaderFieldsLen(t *testing.T) {
+	// withLen returns a copy of helloResponse
+	// with the body and header fields lengths replaced.
+	withLen := func(bodyLen, fieldsLen uint32) []byte {
+		in := append([]byte{}, helloResponse...)
+		binary.LittleEndian.PutUint32(in[4:], bodyLen)
+		binary.LittleEndian.PutUint32(in[12:], fieldsLen)
+		return in
+	}
+	tt := map[string]struct {
+		in     []byte
+		errMsg string
+	}{
+		"max uint32": {
+			in:     withLen(10, math.MaxUint32),
+			errMsg: "header fields exceeded the maximum length: 4294967295/134217728 bytes",
+		},
+		"offset overflow": {
+			in:     withLen(10, math.MaxUint32-8),
+			errMsg: "header fields exceeded the maximum length: 4294967287/134217728 bytes",
+		},
+		"over max": {
+			in:     withLen(10, maxMsgSize+1),
+			errMsg: "header fields exceeded the maximum length: 134217729/134217728 bytes",
+		},
+		"message over max": {
+			in:     withLen(maxMsgSize-16, 61),
+			errMsg: "message exceeded the maximum length: 134217789/134217728 bytes",
+		},
+		"field spills over": {
+			in:     withLen(10, 5),
+			errMsg: "header fields exceeded their length: 14/5 bytes",
+		},
+		"truncated": {
+			in:     helloResponse[:40],
+			errMsg: "header field: failed decoding BYTE at offset 40: EOF",
+		},
+	}
+
+	conv := newStringConverter(DefaultStringConverterSize)
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			dec := newDecoder(bytes.NewReader(tc.in))
+
+			err := decodeHeader(dec, conv, &header{}, false)
+			if err == nil || tc.errMsg != err.Error() {
+				t.Fatalf("expected error %q got %q", tc.errMsg, err)
+			}
+		})
+	}
+}
+
 func FuzzDecodeHeader(f *testing.F) {
 	conv := newStringConverter(DefaultStringConverterSize)
 
@@ -217,6 +273,15 @@ func FuzzDecodeHeader(f *testing.F) {
 	for _, tc := range tt {
 		f.Add(tc)
 	}
+	// The adversarial header fields lengths.
+	for _, n := range []uint32{0, 1, 5, maxMsgSize, maxMsgSize + 1, math.MaxUint32 - 8, math.MaxUint32} {
+		in := append([]byte{}, helloResponse...)
+		binary.LittleEndian.PutUint32(in[12:], n)
+		f.Add(in)
+	}
+
+	// The header field string with an absurd length prefix.
+	f.Add([]byte("l\x02\x01\x01\n\x00\x00\x00\x01\x00\x00\x00=\x00\x00\x00\x06\x01s\x00\x00\x05\x01u\x01\x00\x00\x00\b\x01g\x01"))
 
 	f.Fuzz(func(t *testing.T, orig []byte) {
 		dec := newDecoder(bytes.NewReader(orig))
@@ -343,6 +408,61 @@ func TestEncodeHeader(t *testing.T) {
 	}
 }
 
+func TestEncodeHeaderRoundTrip(t *testing.T) {
+	want := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodReply,
+		Flags:     1,
+		Proto:     1,
+		BodyLen:   35714,
+		Serial:    1758,
+		Fields: []headerField{
+			{Signature: "u", U: 2, Code: fieldReplySerial},
+			{Signature: "s", S: ":1.308", Code: fieldDestination},
+			// The body signature has many types,
+			// though the variant holds a single SIGNATURE type.
+			{Signature: "g", S: "a(ssssssouso)", Code: fieldSignature},
+			{Signature: "s", S: ":1.0", Code: fieldSender},
+		},
+	}
+
+	dst := bytes.Buffer{}
+	if err := encodeHeader(newEncoder(&dst), &want); err != nil {
+		t.Fatal(err)
+	}
+	// The fields length is calculated by the encoder.
+	want.FieldsLen = 61
+
+	var got header
+	conv := newStringConverter(DefaultStringConverterSize)
+	dec := newDecoder(bytes.NewReader(dst.Bytes()))
+	if err := decodeHeader(dec, conv, &got, false); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEncodeHeaderSignatureLen(t *testing.T) {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    1,
+		Fields: []headerField{
+			{Signature: "g", S: strings.Repeat("s", maxSignatureLen+1), Code: fieldSignature},
+		},
+	}
+
+	dst := bytes.Buffer{}
+	err := encodeHeader(newEncoder(&dst), &h)
+	want := "signature exceeded the maximum length: 256/255 bytes"
+	if err == nil || err.Error() != want {
+		t.Errorf("expected %q got %v", want, err)
+	}
+}
+
 func BenchmarkEncodeHeader(b *testing.B) {
 	dst := &bytes.Buffer{}
 	enc := newEncoder(dst)
@@ -0,0 +1,55 @@
+package systemd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseInterfaces returns the names of the interfaces
+// which the object implements according to its introspection XML
+// returned by Client.Introspect, e.g.,
+// org.freedesktop.DBus.Peer and org.freedesktop.systemd1.Unit,
+// see https://dbus.freedesktop.org/doc/dbus-specification.html#introspection-format.
+//
+// The rest of the introspection such as methods, signals, properties,
+// and annotations is skipped.
+// The interfaces of the child nodes are ignored as well.
+func ParseInterfaces(data string) ([]string, error) {
+	var (
+		dec    = xml.NewDecoder(strings.NewReader(data))
+		ifaces []string
+		// depth is the nesting level of the current element,
+		// e.g., the root node is at depth 1,
+		// and its interfaces are at depth 2.
+		depth int
+	)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return ifaces, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse introspection xml: %w", err)
+		}
+
+		switch el := tok.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 1 && el.Name.Local != "node" {
+				return nil, fmt.Errorf("parse introspection xml: unexpected root element %s", el.Name.Local)
+			}
+			if depth != 2 || el.Name.Local != "interface" {
+				continue
+			}
+			for _, a := range el.Attr {
+				if a.Name.Local == "name" {
+					ifaces = append(ifaces, a.Value)
+				}
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
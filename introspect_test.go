@@ -0,0 +1,227 @@
+package systemd
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseInterfaces(t *testing.T) {
+	tt := map[string]struct {
+		in   string
+		want []string
+	}{
+		"unit": {
+			in: unitIntrospection,
+			want: []string{
+				"org.freedesktop.DBus.Peer",
+				"org.freedesktop.DBus.Introspectable",
+				"org.freedesktop.DBus.Properties",
+				"org.freedesktop.systemd1.Service",
+				"org.freedesktop.systemd1.Unit",
+			},
+		},
+		"manager": {
+			in: managerIntrospection,
+			want: []string{
+				"org.freedesktop.DBus.Peer",
+				"org.freedesktop.DBus.Introspectable",
+				"org.freedesktop.DBus.Properties",
+				"org.freedesktop.systemd1.Manager",
+			},
+		},
+		"child node interfaces": {
+			in:   `<node><interface name="a.b"/><node name="c"><interface name="c.d"/></node></node>`,
+			want: []string{"a.b"},
+		},
+		"nameless interface": {
+			in:   `<node><interface/><interface name="a.b"></interface></node>`,
+			want: []string{"a.b"},
+		},
+		"no interfaces": {
+			in: `<node/>`,
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseInterfaces(tc.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestParseInterfacesError(t *testing.T) {
+	tt := map[string]struct {
+		in     string
+		errMsg string
+	}{
+		"unclosed element": {
+			in:     `<node><interface name="a.b">`,
+			errMsg: "parse introspection xml: XML syntax error on line 1: unexpected EOF",
+		},
+		"root element": {
+			in:     `<html><interface name="a.b"/></html>`,
+			errMsg: "parse introspection xml: unexpected root element html",
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseInterfaces(tc.in)
+			if err == nil || tc.errMsg != err.Error() {
+				t.Fatalf("expected error %q got %q", tc.errMsg, err)
+			}
+		})
+	}
+}
+
+// unitIntrospection is a reply to Introspect method
+// for "/org/freedesktop/systemd1/unit/dbus_2eservice" object (systemd 252),
+// most of the properties and methods are omitted for brevity.
+const unitIntrospection = `<!DOCTYPE node PUBLIC "-//freedesktop//DTD D-BUS Object Introspection 1.0//EN"
+"http://www.freedesktop.org/standards/dbus/1.0/introspect.dtd">
+<node>
+ <interface name="org.freedesktop.DBus.Peer">
+  <method name="Ping"/>
+  <method name="GetMachineId">
+   <arg type="s" name="machine_uuid" direction="out"/>
+  </method>
+ </interface>
+ <interface name="org.freedesktop.DBus.Introspectable">
+  <method name="Introspect">
+   <arg name="xml_data" type="s" direction="out"/>
+  </method>
+ </interface>
+ <interface name="org.freedesktop.DBus.Properties">
+  <method name="Get">
+   <arg name="interface_name" direction="in" type="s"/>
+   <arg name="property_name" direction="in" type="s"/>
+   <arg name="value" direction="out" type="v"/>
+  </method>
+  <method name="GetAll">
+   <arg name="interface_name" direction="in" type="s"/>
+   <arg name="props" direction="out" type="a{sv}"/>
+  </method>
+  <method name="Set">
+   <arg name="interface_name" direction="in" type="s"/>
+   <arg name="property_name" direction="in" type="s"/>
+   <arg name="value" direction="in" type="v"/>
+  </method>
+  <signal name="PropertiesChanged">
+   <arg type="s" name="interface_name"/>
+   <arg type="a{sv}" name="changed_properties"/>
+   <arg type="as" name="invalidated_properties"/>
+  </signal>
+ </interface>
+ <interface name="org.freedesktop.systemd1.Service">
+  <property name="Type" type="s" access="read">
+   <annotation name="org.freedesktop.DBus.Property.EmitsChangedSignal" value="const"/>
+  </property>
+  <property name="MainPID" type="u" access="read">
+  </property>
+  <property name="NRestarts" type="u" access="read">
+  </property>
+  <property name="ExecStart" type="a(sasbttttuii)" access="read">
+   <annotation name="org.freedesktop.DBus.Property.EmitsChangedSignal" value="invalidates"/>
+  </property>
+  <property name="MemoryCurrent" type="t" access="read">
+   <annotation name="org.freedesktop.DBus.Property.EmitsChangedSignal" value="false"/>
+  </property>
+  <method name="BindMount">
+   <arg type="s" name="source" direction="in"/>
+   <arg type="s" name="destination" direction="in"/>
+   <arg type="b" name="read_only" direction="in"/>
+   <arg type="b" name="mkdir" direction="in"/>
+  </method>
+  <method name="GetProcesses">
+   <arg type="a(sus)" name="processes" direction="out"/>
+  </method>
+  <method name="AttachProcesses">
+   <arg type="s" name="subcgroup" direction="in"/>
+   <arg type="au" name="pids" direction="in"/>
+  </method>
+ </interface>
+ <interface name="org.freedesktop.systemd1.Unit">
+  <property name="Id" type="s" access="read">
+  </property>
+  <property name="Names" type="as" access="read">
+  </property>
+  <property name="LoadState" type="s" access="read">
+  </property>
+  <property name="ActiveState" type="s" access="read">
+  </property>
+  <property name="SubState" type="s" access="read">
+  </property>
+  <property name="Job" type="(uo)" access="read">
+  </property>
+  <method name="Start">
+   <arg type="s" name="mode" direction="in"/>
+   <arg type="o" name="job" direction="out"/>
+  </method>
+  <method name="Stop">
+   <arg type="s" name="mode" direction="in"/>
+   <arg type="o" name="job" direction="out"/>
+  </method>
+  <method name="Kill">
+   <arg type="s" name="whom" direction="in"/>
+   <arg type="i" name="signal" direction="in"/>
+  </method>
+ </interface>
+</node>
+`
+
+// managerIntrospection is a reply to Introspect method
+// for "/org/freedesktop/systemd1" object (systemd 252),
+// most of the properties, methods, and child nodes are omitted for brevity.
+const managerIntrospection = `<!DOCTYPE node PUBLIC "-//freedesktop//DTD D-BUS Object Introspection 1.0//EN"
+"http://www.freedesktop.org/standards/dbus/1.0/introspect.dtd">
+<node>
+ <interface name="org.freedesktop.DBus.Peer">
+  <method name="Ping"/>
+  <method name="GetMachineId">
+   <arg type="s" name="machine_uuid" direction="out"/>
+  </method>
+ </interface>
+ <interface name="org.freedesktop.DBus.Introspectable">
+  <method name="Introspect">
+   <arg name="xml_data" type="s" direction="out"/>
+  </method>
+ </interface>
+ <interface name="org.freedesktop.DBus.Properties">
+  <method name="GetAll">
+   <arg name="interface_name" direction="in" type="s"/>
+   <arg name="props" direction="out" type="a{sv}"/>
+  </method>
+ </interface>
+ <interface name="org.freedesktop.systemd1.Manager">
+  <property name="Version" type="s" access="read">
+   <annotation name="org.freedesktop.DBus.Property.EmitsChangedSignal" value="const"/>
+  </property>
+  <property name="NFailedUnits" type="u" access="read">
+  </property>
+  <method name="GetUnit">
+   <arg type="s" name="name" direction="in"/>
+   <arg type="o" name="unit" direction="out"/>
+  </method>
+  <method name="ListUnits">
+   <arg type="a(ssssssouso)" name="units" direction="out"/>
+  </method>
+  <method name="Subscribe">
+  </method>
+  <signal name="JobRemoved">
+   <arg type="u" name="id"/>
+   <arg type="o" name="job"/>
+   <arg type="s" name="unit"/>
+   <arg type="s" name="result"/>
+  </signal>
+ </interface>
+ <node name="job"/>
+ <node name="unit"/>
+</node>
+`
@@ -0,0 +1,54 @@
+package systemd
+
+import "io"
+
+// Session represents a login session managed by systemd-logind.
+type Session struct {
+	// ID is the session ID, e.g., "2".
+	ID string
+	// UID is the user ID of the session owner.
+	UID uint32
+	// User is the user name of the session owner.
+	User string
+	// Seat is the seat ID, e.g., "seat0",
+	// or the empty string if the session isn't attached to a seat,
+	// e.g., an SSH session.
+	Seat string
+	// Path is the session object path,
+	// e.g., "/org/freedesktop/login1/session/_32".
+	Path string
+}
+
+// The systemd-logind's bus name, object path, and interface
+// which the helpers such as ListSessions call, see Client.Call.
+const (
+	logindBusName   = "org.freedesktop.login1"
+	logindPath      = "/org/freedesktop/login1"
+	logindInterface = "org.freedesktop.login1.Manager"
+)
+
+// ListSessions fetches the current login sessions
+// from systemd-logind (org.freedesktop.login1)
+// which is reachable over the same system message bus as systemd.
+func (c *Client) ListSessions(opts ...CallOption) ([]Session, error) {
+	var sessions []Session
+	err := c.callMethod(logindBusName, logindPath, logindInterface, "ListSessions", nil, opts,
+		func(conn io.Reader) (err error) {
+			sessions, err = c.msgDec.DecodeListSessions(conn)
+			return err
+		},
+	)
+	return sessions, err
+}
+
+// LockSession asks the session with the given ID to activate the screen lock.
+// Note, the caller needs a permission to do so,
+// otherwise org.freedesktop.DBus.Error.AccessDenied error is returned.
+func (c *Client) LockSession(id string, opts ...CallOption) error {
+	args := []Variant{{Signature: "s", S: id}}
+	return c.callMethod(logindBusName, logindPath, logindInterface, "LockSession", args, opts,
+		func(conn io.Reader) error {
+			return c.msgDec.DecodeEmptyReply(conn)
+		},
+	)
+}
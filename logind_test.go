@@ -0,0 +1,91 @@
+package systemd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEncodeMethodCall(t *testing.T) {
+	tt := map[string]struct {
+		member string
+		args   []Variant
+		want   []byte
+	}{
+		"no args": {
+			member: "ListSessions",
+			want:   listSessionsRequest,
+		},
+		"string arg": {
+			member: "LockSession",
+			args:   []Variant{{Signature: "s", S: "2"}},
+			want:   lockSessionRequest,
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			msgEnc := newMessageEncoder()
+			conn := &bytes.Buffer{}
+			err := msgEnc.EncodeMethodCall(conn, logindBusName, logindPath, logindInterface, tc.member, tc.args, 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.want, conn.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestClientListSessions(t *testing.T) {
+	conn := &replayConn{replies: bytes.NewReader(listSessionsResponse)}
+	c := newClient(nil)
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	got, err := c.ListSessions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Session{
+		{ID: "2", UID: 1000, User: "alice", Seat: "seat0", Path: "/org/freedesktop/login1/session/_32"},
+		{ID: "7", UID: 1001, User: "bob", Seat: "", Path: "/org/freedesktop/login1/session/_37"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+	if _, err = c.bufConn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+func TestClientLockSession(t *testing.T) {
+	// The reply has no arguments similar to Ping.
+	conn := &replayConn{replies: bytes.NewReader(pingResponse)}
+	c := newClient(nil)
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	if err := c.LockSession("2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.bufConn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+// listSessionsRequest is a D-Bus message to request the login sessions.
+var listSessionsRequest = []byte{108, 1, 0, 1, 0, 0, 0, 0, 3, 0, 0, 0, 127, 0, 0, 0, 3, 1, 115, 0, 12, 0, 0, 0, 76, 105, 115, 116, 83, 101, 115, 115, 105, 111, 110, 115, 0, 0, 0, 0, 2, 1, 115, 0, 30, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 108, 111, 103, 105, 110, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 1, 1, 111, 0, 23, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 108, 111, 103, 105, 110, 49, 0, 6, 1, 115, 0, 22, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 108, 111, 103, 105, 110, 49, 0, 0}
+
+// lockSessionRequest is a D-Bus message to lock the session "2".
+var lockSessionRequest = []byte{108, 1, 0, 1, 6, 0, 0, 0, 3, 0, 0, 0, 135, 0, 0, 0, 3, 1, 115, 0, 11, 0, 0, 0, 76, 111, 99, 107, 83, 101, 115, 115, 105, 111, 110, 0, 0, 0, 0, 0, 2, 1, 115, 0, 30, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 108, 111, 103, 105, 110, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 1, 1, 111, 0, 23, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 108, 111, 103, 105, 110, 49, 0, 6, 1, 115, 0, 22, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 108, 111, 103, 105, 110, 49, 0, 0, 8, 1, 103, 0, 1, 115, 0, 0, 1, 0, 0, 0, 50, 0}
+
+// listSessionsResponse is a reply to listSessionsRequest
+// with a graphical session on seat0 and an SSH session without a seat.
+var listSessionsResponse = []byte{108, 2, 1, 1, 156, 0, 0, 0, 240, 5, 0, 0, 53, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 8, 97, 40, 115, 117, 115, 115, 111, 41, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 148, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 50, 0, 0, 0, 232, 3, 0, 0, 5, 0, 0, 0, 97, 108, 105, 99, 101, 0, 0, 0, 5, 0, 0, 0, 115, 101, 97, 116, 48, 0, 0, 0, 35, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 108, 111, 103, 105, 110, 49, 47, 115, 101, 115, 115, 105, 111, 110, 47, 95, 51, 50, 0, 0, 0, 0, 0, 1, 0, 0, 0, 55, 0, 0, 0, 233, 3, 0, 0, 3, 0, 0, 0, 98, 111, 98, 0, 0, 0, 0, 0, 0, 0, 0, 0, 35, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 108, 111, 103, 105, 110, 49, 47, 115, 101, 115, 115, 105, 111, 110, 47, 95, 51, 55, 0}
@@ -2,8 +2,13 @@ package systemd
 
 import (
 	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"net"
+	"os"
 	"reflect"
 )
 
@@ -37,6 +42,345 @@ type Unit struct {
 	JobPath string
 }
 
+// UnitProcess represents a process which belongs to a unit,
+// see Client.GetUnitProcesses.
+type UnitProcess struct {
+	// CgroupPath is the control group of the process
+	// relative to the cgroup hierarchy root,
+	// e.g., "/system.slice/dbus.service".
+	CgroupPath string
+	// PID is the process ID.
+	PID uint32
+	// Command is the process command line,
+	// or the process name in brackets for kernel threads, e.g., "[kworker/0:1]".
+	Command string
+}
+
+// UnitFile represents a unit file installed on the host
+// and its enablement state, see Client.ListUnitFiles.
+type UnitFile struct {
+	// Path is the unit file path,
+	// e.g., "/lib/systemd/system/ssh.service".
+	Path string
+	// State is the enablement state of the unit file,
+	// e.g., "enabled", "disabled", "static", or "masked".
+	State string
+}
+
+// UnitFileChange represents a change of the unit files made by systemd
+// when a unit file is enabled or disabled,
+// see Client.EnableUnitFiles and Client.DisableUnitFiles.
+type UnitFileChange struct {
+	// Type is the type of the change, e.g., "symlink" or "unlink".
+	Type string
+	// Filename is the file name of the symlink,
+	// e.g., "/etc/systemd/system/multi-user.target.wants/ssh.service".
+	Filename string
+	// Destination is the destination of the symlink,
+	// e.g., "/lib/systemd/system/ssh.service".
+	// It's empty if the symlink was removed.
+	Destination string
+}
+
+// SystemStatus represents the overall state of the service manager
+// found in the properties of org.freedesktop.systemd1.Manager interface.
+type SystemStatus struct {
+	// Version is the systemd version, e.g., "255.4-1ubuntu8".
+	Version string
+	// Features are the compile-time features, e.g., "+PAM +AUDIT -SELINUX".
+	Features string
+	// Virtualization is the virtualization technology
+	// the system runs in, e.g., "kvm", or the empty string.
+	Virtualization string
+	// Architecture is the architecture of the kernel, e.g., "x86-64".
+	Architecture string
+	// Tainted lists the reasons why the system is tainted,
+	// e.g., "unmerged-usr", or the empty string.
+	Tainted string
+	// SystemState is the state of the system, e.g.,
+	// "initializing", "starting", "running", "degraded", "maintenance", "stopping".
+	SystemState string
+	// NNames is the number of currently loaded units.
+	NNames uint32
+	// NFailedUnits is the number of units in the failed state.
+	NFailedUnits uint32
+	// NJobs is the number of currently queued jobs.
+	NJobs uint32
+	// NInstalledJobs is the number of jobs installed since boot.
+	NInstalledJobs uint32
+	// NFailedJobs is the number of jobs which failed since boot.
+	NFailedJobs uint32
+	// Progress is the boot progress from 0 to 1.
+	Progress float64
+	// UserspaceTimestamp is the time (in microseconds since the epoch)
+	// when the service manager started.
+	UserspaceTimestamp uint64
+	// FinishTimestamp is the time (in microseconds since the epoch)
+	// when the startup finished, or zero if it's still ongoing.
+	FinishTimestamp uint64
+}
+
+// set sets the status field corresponding to the Manager property.
+// The unknown properties and the properties of unexpected type are skipped.
+func (s *SystemStatus) set(name string, v Variant) {
+	switch sig := v.Signature; {
+	case name == "Version" && sig == "s":
+		s.Version = v.S
+	case name == "Features" && sig == "s":
+		s.Features = v.S
+	case name == "Virtualization" && sig == "s":
+		s.Virtualization = v.S
+	case name == "Architecture" && sig == "s":
+		s.Architecture = v.S
+	case name == "Tainted" && sig == "s":
+		s.Tainted = v.S
+	case name == "SystemState" && sig == "s":
+		s.SystemState = v.S
+	case name == "NNames" && sig == "u":
+		s.NNames = uint32(v.U)
+	case name == "NFailedUnits" && sig == "u":
+		s.NFailedUnits = uint32(v.U)
+	case name == "NJobs" && sig == "u":
+		s.NJobs = uint32(v.U)
+	case name == "NInstalledJobs" && sig == "u":
+		s.NInstalledJobs = uint32(v.U)
+	case name == "NFailedJobs" && sig == "u":
+		s.NFailedJobs = uint32(v.U)
+	case name == "Progress" && sig == "d":
+		s.Progress = v.F
+	case name == "UserspaceTimestamp" && sig == "t":
+		s.UserspaceTimestamp = v.U
+	case name == "FinishTimestamp" && sig == "t":
+		s.FinishTimestamp = v.U
+	}
+}
+
+// UnitFileState is the enablement state of a unit file
+// returned by systemd GetUnitFileState method.
+type UnitFileState string
+
+// Unit file states,
+// see https://www.freedesktop.org/software/systemd/man/systemctl.html, "is-enabled" command.
+const (
+	// UnitFileEnabled means the unit file is enabled via .wants/, .requires/ or alias symlinks
+	// in /etc/systemd/system/.
+	UnitFileEnabled UnitFileState = "enabled"
+	// UnitFileEnabledRuntime is the same as UnitFileEnabled,
+	// but the symlinks are in /run/systemd/system/, i.e., until the next reboot.
+	UnitFileEnabledRuntime UnitFileState = "enabled-runtime"
+	// UnitFileLinked means the unit file is made available
+	// through a symlink from outside of the unit search path.
+	UnitFileLinked UnitFileState = "linked"
+	// UnitFileLinkedRuntime is the same as UnitFileLinked, but until the next reboot.
+	UnitFileLinkedRuntime UnitFileState = "linked-runtime"
+	// UnitFileAlias means the name is an alias of another unit file.
+	UnitFileAlias UnitFileState = "alias"
+	// UnitFileMasked means the unit file is linked to /dev/null,
+	// so the unit can't be started.
+	UnitFileMasked UnitFileState = "masked"
+	// UnitFileMaskedRuntime is the same as UnitFileMasked, but until the next reboot.
+	UnitFileMaskedRuntime UnitFileState = "masked-runtime"
+	// UnitFileStatic means the unit file has no [Install] section,
+	// so it can't be enabled by a user,
+	// but it may be pulled in as a dependency of another unit.
+	UnitFileStatic UnitFileState = "static"
+	// UnitFileIndirect means the unit file itself isn't enabled,
+	// but it has Also= setting in [Install] section listing the enabled units.
+	UnitFileIndirect UnitFileState = "indirect"
+	// UnitFileDisabled means the unit file has [Install] section, but it's not enabled.
+	UnitFileDisabled UnitFileState = "disabled"
+	// UnitFileGenerated means the unit file was generated dynamically by a generator.
+	UnitFileGenerated UnitFileState = "generated"
+	// UnitFileTransient means the unit file was created dynamically with the runtime API.
+	UnitFileTransient UnitFileState = "transient"
+	// UnitFileBad means the unit file is invalid or another error occurred.
+	UnitFileBad UnitFileState = "bad"
+)
+
+// IsEnabled reports whether the unit file is enabled,
+// i.e., it is started at boot according to its [Install] section.
+// A static unit file is not enabled,
+// since a user can't enable it, even though the unit might be started
+// as a dependency of another unit.
+// Neither are generated and transient unit files,
+// because they live outside of the enable/disable model,
+// see IsGenerated and IsTransient.
+func (s UnitFileState) IsEnabled() bool {
+	switch s {
+	case UnitFileEnabled, UnitFileEnabledRuntime, UnitFileAlias, UnitFileIndirect:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsGenerated reports whether the unit file was generated by a generator,
+// e.g., a mount unit generated from /etc/fstab.
+// Such a unit is pulled in by the generator rather than being enabled,
+// so it shouldn't be reported as disabled.
+func (s UnitFileState) IsGenerated() bool {
+	return s == UnitFileGenerated
+}
+
+// IsTransient reports whether the unit file was created with the runtime API,
+// e.g., by systemd-run, so it's gone once the unit is stopped.
+func (s UnitFileState) IsTransient() bool {
+	return s == UnitFileTransient
+}
+
+// Signal represents a D-Bus signal, e.g.,
+// org.freedesktop.systemd1.Manager.UnitNew emitted by systemd.
+type Signal struct {
+	// Sender is a unique name of the connection which emitted the signal,
+	// e.g., ":1.3".
+	Sender string
+	// Path is the object the signal is emitted from,
+	// e.g., "/org/freedesktop/systemd1".
+	Path string
+	// Interface is the interface the signal is emitted from,
+	// e.g., "org.freedesktop.systemd1.Manager".
+	Interface string
+	// Member is the name of the signal, e.g., "UnitNew".
+	Member string
+	// Signature is the signature of the signal arguments, e.g., "so".
+	Signature string
+	// Args are the signal arguments, one per single complete type
+	// in the signature.
+	Args []Variant
+}
+
+// JobRemoved represents org.freedesktop.systemd1.Manager.JobRemoved signal
+// which systemd emits when a job finishes, see Signal.JobRemoved.
+type JobRemoved struct {
+	// ID is the numeric job ID, e.g., 1292.
+	ID uint32
+	// Path is the job object path,
+	// e.g., "/org/freedesktop/systemd1/job/1292",
+	// which is returned by StartUnit, StopUnit, and RestartUnit.
+	Path string
+	// Unit is the name of the unit the job was enqueued for,
+	// e.g., "dbus.service".
+	Unit string
+	// Result is the job result, e.g., "done", "canceled", "timeout",
+	// "failed", "dependency", or "skipped".
+	Result string
+}
+
+// JobRemoved returns the arguments of JobRemoved signal.
+// The returned ok is false if the signal isn't JobRemoved.
+func (s *Signal) JobRemoved() (j JobRemoved, ok bool) {
+	// JobRemoved signal has the "uoss" signature, i.e.,
+	// the job ID, the job path, the unit name, and the job result.
+	if s.Member != "JobRemoved" || s.Interface != "org.freedesktop.systemd1.Manager" || s.Signature != "uoss" {
+		return j, false
+	}
+
+	j = JobRemoved{
+		ID:     uint32(s.Args[0].U),
+		Path:   s.Args[1].S,
+		Unit:   s.Args[2].S,
+		Result: s.Args[3].S,
+	}
+	return j, true
+}
+
+// Reloading returns the argument of Reloading signal
+// which systemd emits with active set to true when it starts reloading
+// its configuration, and with false once the reload is finished.
+// The returned ok is false if the signal isn't Reloading.
+func (s *Signal) Reloading() (active, ok bool) {
+	// Reloading signal has the "b" signature.
+	if s.Member != "Reloading" || s.Interface != "org.freedesktop.systemd1.Manager" || s.Signature != "b" {
+		return false, false
+	}
+
+	return s.Args[0].U != 0, true
+}
+
+// PropertiesChanged represents org.freedesktop.DBus.Properties.PropertiesChanged
+// signal which systemd emits when the properties of its object change,
+// see Signal.PropertiesChanged.
+type PropertiesChanged struct {
+	// Path is the object whose properties changed,
+	// e.g., "/org/freedesktop/systemd1/unit/dbus_2eservice".
+	Path string
+	// Interface is the interface the properties belong to,
+	// e.g., "org.freedesktop.systemd1.Unit".
+	Interface string
+	// Changed are the changed properties with their new values,
+	// e.g., ActiveState.
+	Changed map[string]Variant
+	// Invalidated are the names of the changed properties
+	// whose values weren't sent, so they have to be fetched if needed.
+	Invalidated []string
+}
+
+// PropertiesChanged returns the arguments of PropertiesChanged signal.
+// The returned ok is false if the signal isn't PropertiesChanged.
+// An error is returned if the changed properties couldn't be decoded.
+func (s *Signal) PropertiesChanged() (p PropertiesChanged, ok bool, err error) {
+	// PropertiesChanged signal has the "sa{sv}as" signature, i.e.,
+	// the interface name, the changed properties with their values,
+	// and the invalidated properties without the values.
+	if s.Member != "PropertiesChanged" || s.Interface != "org.freedesktop.DBus.Properties" || s.Signature != "sa{sv}as" {
+		return p, false, nil
+	}
+
+	p = PropertiesChanged{
+		Path:        s.Path,
+		Interface:   s.Args[0].S,
+		Changed:     make(map[string]Variant),
+		Invalidated: s.Args[2].Strings,
+	}
+	if s.Args[1].Raw == nil {
+		return p, true, nil
+	}
+	err = eachRawProperty(s.Args[1].Raw, func(name string, v Variant) bool {
+		p.Changed[name] = v
+		return true
+	})
+	return p, true, err
+}
+
+// Variant is a D-Bus value along with its type signature,
+// e.g., a property value returned by org.freedesktop.DBus.Properties.GetAll.
+type Variant struct {
+	// Signature is a signature (single complete type) of the value, e.g., "s".
+	Signature string
+	// The following fields contain the value depending on signature.
+	// The decision was made against an interface{} to reduce allocs.
+	//
+	// U contains BYTE (y), BOOLEAN (b), UINT16 (q), UINT32 (u), UINT64 (t).
+	U uint64
+	// I contains INT16 (n), INT32 (i), INT64 (x).
+	I int64
+	// F contains DOUBLE (d).
+	F float64
+	// S contains STRING (s), OBJECT_PATH (o), SIGNATURE (g).
+	S string
+	// Strings contains ARRAY of STRING (as),
+	// or STRUCT of two STRINGs (ss), e.g., a unit's LoadError property.
+	Strings []string
+	// Raw contains a value whose type isn't fully supported by the decoder,
+	// e.g., ExecStart property "a(sasbttttuii)".
+	// It is nil for the supported types.
+	Raw *RawVariant
+}
+
+// RawVariant is a variant value that wasn't decoded,
+// so a caller can decode it.
+type RawVariant struct {
+	// Signature is a signature of the value, e.g., "a(sasbttttuii)".
+	Signature string
+	// Bytes is the encoded value without the preceding alignment padding.
+	// For arrays, it contains the array elements without the array length.
+	Bytes []byte
+	// Offset is the position of Bytes in the message modulo 8.
+	// It's needed to decode Bytes, because the alignment padding
+	// within the value is relative to the start of the message.
+	Offset uint32
+}
+
 // Predicate is used to filter out a decoded struct
 // based on its field index and a value.
 // This helps to reduce memory consumption
@@ -64,6 +408,25 @@ func IsService(fieldIndex int, s []byte) bool {
 	}
 }
 
+// PathHasPrefix returns a predicate that filters units
+// whose object path (field index 6) begins with the prefix,
+// e.g., "/org/freedesktop/systemd1/unit/dev_2d" matches the device units
+// such as "/org/freedesktop/systemd1/unit/dev_2dsda.device".
+// Note, the unit name is escaped in the path,
+// i.e., the characters other than ASCII letters and digits
+// are replaced with "_" and their hex code, e.g., "-" is "_2d".
+func PathHasPrefix(prefix string) Predicate {
+	b := []byte(prefix)
+	return func(fieldIndex int, s []byte) bool {
+		switch fieldIndex {
+		case 6:
+			return bytes.HasPrefix(s, b)
+		default:
+			return true
+		}
+	}
+}
+
 func newMessageDecoder() *messageDecoder {
 	return &messageDecoder{
 		Dec:              newDecoder(nil),
@@ -76,13 +439,38 @@ func newMessageDecoder() *messageDecoder {
 type messageDecoder struct {
 	Dec  *decoder
 	Conv *stringConverter
+	// UnitConv converts the unit fields in DecodeListUnits if it's set,
+	// otherwise Conv is used.
+	// It's reset before decoding the units,
+	// so its buffers are reused by the following ListUnits calls.
+	UnitConv *stringConverter
 	// SkipHeaderFields indicates to the decoder that
 	// the header fields shouldn't be decoded thus reducing allocs.
 	SkipHeaderFields bool
+	// CheckProto indicates to the decoder that
+	// the protocol version of a message must be verified.
+	CheckProto bool
+	// Strict indicates to the decoder that
+	// the messages must be validated, see WithStrictDecoding.
+	// It implies CheckProto.
+	Strict bool
+	// UnixFDs points to the Unix file descriptors received from the connection.
+	// It is nil if file descriptor passing is not enabled.
+	UnixFDs *[]int
+	// ReplySerial is the serial of the method call whose reply is expected.
+	// The replies to other calls are discarded along with the signals
+	// until the matching reply arrives, e.g., a late reply to a timed out call.
+	// Zero means any reply is accepted.
+	ReplySerial uint32
+	// Ctx interrupts DecodeListUnits between the units once it's done,
+	// so a long reply isn't decoded in vain, see Client.ListUnitsContext.
+	// It is nil when the call has no context.
+	Ctx context.Context
 
 	// The following fields are reused to reduce memory allocs.
 	bodyReader io.LimitedReader
 	unit       Unit
+	variant    Variant
 	hdr        header
 }
 
@@ -94,28 +482,148 @@ func (d *messageDecoder) Header() *header {
 	return &d.hdr
 }
 
+// decodeReply decodes the header of a method reply
+// and resets the decoder to read the message body from conn.
+// The signals that came before the expected reply are discarded,
+// e.g., "name acquired" signal or the signals the connection subscribed to,
+// as well as the replies to other calls, see ReplySerial.
+// An error reply is decoded and returned as an error.
+func (d *messageDecoder) decodeReply(conn io.Reader) error {
+	for {
+		d.Dec.Reset(conn)
+
+		// Decode the message header (16 bytes).
+		//
+		// Then read the message header where the body signature is stored.
+		// The header usually occupies 61 bytes.
+		// Since we already know the signature from the spec,
+		// the header is discarded.
+		//
+		// Note, the length of the header must be a multiple of 8,
+		// allowing the body to begin on an 8-byte boundary.
+		// If the header does not naturally end on an 8-byte boundary,
+		// up to 7 bytes of alignment padding is added.
+		err := decodeHeader(d.Dec, d.Conv, &d.hdr, d.SkipHeaderFields)
+		if err != nil {
+			return fmt.Errorf("message header: %w", err)
+		}
+		if err = d.checkHeader(); err != nil {
+			return err
+		}
+
+		// Read the message body limited by the body length.
+		// For example, if it is 35714 bytes,
+		// we should stop reading at offset 35794,
+		// because the body starts at offset 80,
+		// i.e., offset 35794 = 16 head + 61 header + 3 padding + 35714 body.
+		//
+		// The offset isn't reset, because the alignment is relative
+		// to the message start, not the body.
+		// The body always starts on an 8-byte boundary,
+		// so a zero offset would align the same way,
+		// but the decoder's offset would no longer be a position in the message.
+		d.bodyReader.R = conn
+		d.bodyReader.N = int64(d.hdr.BodyLen)
+		d.Dec.SetSource(&d.bodyReader)
+		d.Dec.SetLimit(d.hdr.BodyLen)
+		// The file descriptors are received along with the first bytes of the message,
+		// so they are available once the header is decoded.
+		if d.UnixFDs != nil {
+			d.Dec.SetUnixFDs(*d.UnixFDs)
+		}
+
+		// Discard the reply to another method call.
+		// The reply serial is unknown (zero) if the header fields
+		// didn't fit into the max buffer size.
+		if d.ReplySerial != 0 && d.hdr.Type != msgTypeSignal {
+			if rs := d.hdr.replySerial(); rs != 0 && rs != d.ReplySerial {
+				if err = d.Dec.Skip(d.hdr.BodyLen); err != nil {
+					return fmt.Errorf("discard reply body: %w", err)
+				}
+				continue
+			}
+		}
+
+		switch d.hdr.Type {
+		// Decode an error reply, e.g., invalid unit name.
+		case msgTypeError:
+			dbusErr := DBusError{Name: d.hdr.errorName()}
+			// The error message is the first argument if there is one.
+			if d.hdr.BodyLen > 0 {
+				s, err := d.Dec.String()
+				if err != nil {
+					return fmt.Errorf("decode error reply: %w", err)
+				}
+				dbusErr.Message = d.Conv.String(s)
+			}
+			if err = d.discardBody(); err != nil {
+				return fmt.Errorf("discard error reply: %w", err)
+			}
+			return &dbusErr
+		// Discard the signal that came before the expected reply
+		// and decode the following message.
+		case msgTypeSignal:
+			if err = d.Dec.Skip(d.hdr.BodyLen); err != nil {
+				return fmt.Errorf("discard signal body: %w", err)
+			}
+			continue
+		}
+
+		if d.Strict && d.hdr.Type != msgTypeMethodReply {
+			return fmt.Errorf("unexpected message type: %d", d.hdr.Type)
+		}
+
+		return nil
+	}
+}
+
+// checkHeader validates the recently decoded header
+// if CheckProto or Strict mode is enabled.
+func (d *messageDecoder) checkHeader() error {
+	if !d.CheckProto && !d.Strict {
+		return nil
+	}
+
+	if err := d.hdr.checkProto(); err != nil {
+		return err
+	}
+	if d.Strict && d.hdr.Serial == 0 {
+		return fmt.Errorf("message serial must not be zero")
+	}
+
+	return nil
+}
+
+// discardBody discards the rest of the message body
+// which the caller didn't decode, e.g., unexpected trailing arguments.
+// That way the connection is positioned at the start of the next message
+// even if the connection buffer already holds it.
+func (d *messageDecoder) discardBody() error {
+	if d.bodyReader.N <= 0 {
+		return nil
+	}
+
+	return d.Dec.Skip(uint32(d.bodyReader.N))
+}
+
+// finishBody discards the rest of the message body similar to discardBody
+// once the expected arguments are decoded.
+// In Strict mode the rest of the body must be empty.
+func (d *messageDecoder) finishBody() error {
+	if d.Strict && d.bodyReader.N > 0 {
+		return fmt.Errorf("message body has %d undecoded bytes", d.bodyReader.N)
+	}
+
+	return d.discardBody()
+}
+
 // DecodeHello decodes hello reply from systemd
 // org.freedesktop.DBus.Hello method
 // and returns a connection name, e.g., ":1.47".
 func (d *messageDecoder) DecodeHello(conn io.Reader) (string, error) {
-	d.Dec.Reset(conn)
-
-	err := decodeHeader(d.Dec, d.Conv, &d.hdr, d.SkipHeaderFields)
+	err := d.decodeReply(conn)
 	if err != nil {
-		return "", fmt.Errorf("message header: %w", err)
-	}
-
-	d.bodyReader.R = conn
-	d.bodyReader.N = int64(d.hdr.BodyLen)
-	d.Dec.Reset(&d.bodyReader)
-
-	// Decode an error reply.
-	if d.hdr.Type == msgTypeError {
-		s, err := d.Dec.String()
-		if err != nil {
-			return "", fmt.Errorf("decode error reply: %w", err)
-		}
-		return "", fmt.Errorf(d.Conv.String(s))
+		return "", err
 	}
 
 	var connName []byte
@@ -123,6 +631,10 @@ func (d *messageDecoder) DecodeHello(conn io.Reader) (string, error) {
 		return "", fmt.Errorf("decode connection name: %w", err)
 	}
 
+	if err = d.finishBody(); err != nil {
+		return "", fmt.Errorf("discard body: %w", err)
+	}
+
 	return d.Conv.String(connName), nil
 }
 
@@ -130,73 +642,189 @@ func (d *messageDecoder) DecodeHello(conn io.Reader) (string, error) {
 // The pointer to Unit struct in f must not be retained,
 // because its fields change on each f call.
 func (d *messageDecoder) DecodeListUnits(conn io.Reader, p Predicate, f func(*Unit)) error {
-	d.Dec.Reset(conn)
+	err := d.decodeReply(conn)
+	if err != nil {
+		return err
+	}
 
-	// Decode the message header (16 bytes).
-	//
-	// Then read the message header where the body signature is stored.
-	// The header usually occupies 61 bytes.
-	// Since we already know the signature from the spec,
-	// the header is discarded.
-	//
-	// Note, the length of the header must be a multiple of 8,
-	// allowing the body to begin on an 8-byte boundary.
-	// If the header does not naturally end on an 8-byte boundary,
-	// up to 7 bytes of alignment padding is added.
-	err := decodeHeader(d.Dec, d.Conv, &d.hdr, d.SkipHeaderFields)
+	end, err := d.decodeUnitArray()
 	if err != nil {
-		return fmt.Errorf("message header: %w", err)
+		return err
 	}
 
-	// Read the message body limited by the body length.
-	// For example, if it is 35714 bytes,
-	// we should stop reading at offset 35794,
-	// because the body starts at offset 80,
-	// i.e., offset 35794 = 16 head + 61 header + 3 padding + 35714 body.
-	d.bodyReader.R = conn
-	d.bodyReader.N = int64(d.hdr.BodyLen)
-	d.Dec.Reset(&d.bodyReader)
+	conv := d.unitConv()
+	for n := 1; d.Dec.Offset() < end; n++ {
+		if err = d.Dec.checkArrayElements(n); err != nil {
+			return err
+		}
+		err = decodeUnit(d.Dec, conv, p, &d.unit)
+		switch err {
+		case nil:
+			f(&d.unit)
+		case errIgnore:
+		default:
+			return unitBodyError(err)
+		}
 
-	switch d.hdr.Type {
-	// Decode an error reply.
-	case msgTypeError:
-		s, err := d.Dec.String()
-		if err != nil {
-			return fmt.Errorf("decode error reply: %w", err)
+		if d.Ctx != nil && n%ctxCheckInterval == 0 {
+			if err = d.Ctx.Err(); err != nil {
+				return err
+			}
+		}
+	}
+	if err = d.checkArrayEnd(end); err != nil {
+		return err
+	}
+
+	return d.finishBody()
+}
+
+// DecodeFindUnit decodes a reply from systemd ListUnits method
+// until it finds the first unit which satisfies the predicate.
+// The remaining units are discarded without decoding.
+// The returned found is false if none of the units matched.
+func (d *messageDecoder) DecodeFindUnit(conn io.Reader, p Predicate) (unit Unit, found bool, err error) {
+	if err = d.decodeReply(conn); err != nil {
+		return unit, false, err
+	}
+
+	end, err := d.decodeUnitArray()
+	if err != nil {
+		return unit, false, err
+	}
+
+	conv := d.unitConv()
+	for n := 1; d.Dec.Offset() < end; n++ {
+		if err = d.Dec.checkArrayElements(n); err != nil {
+			return unit, false, err
+		}
+		err = decodeUnit(d.Dec, conv, p, &d.unit)
+		if err == nil {
+			unit, found = d.unit, true
+			break
+		}
+		if err != errIgnore {
+			return unit, false, unitBodyError(err)
 		}
-		return fmt.Errorf(d.Conv.String(s))
-	// Discard the signal that came before the expected reply,
-	// i.e., "name acquired" signal.
-	case msgTypeSignal:
-		if _, err = d.Dec.ReadN(d.hdr.BodyLen); err != nil {
-			return fmt.Errorf("discard signal body: %w", err)
+	}
+	if !found {
+		if err = d.checkArrayEnd(end); err != nil {
+			return unit, false, err
 		}
-		// Decode the following message.
-		return d.DecodeListUnits(conn, p, f)
 	}
 
+	// Discard the units which weren't decoded,
+	// so the next message could be read.
+	if err = d.discardBody(); err != nil {
+		return unit, false, fmt.Errorf("discard units: %w", err)
+	}
+
+	return unit, found, nil
+}
+
+// ctxCheckInterval is how many units are decoded
+// between the checks of messageDecoder.Ctx.
+const ctxCheckInterval = 8
+
+// decodeUnitArray decodes the length of the units array in ListUnits reply
+// and returns the offset where the array ends.
+func (d *messageDecoder) decodeUnitArray() (end uint32, err error) {
 	// ListUnits has a body signature "a(ssssssouso)" which is
 	// ARRAY of STRUCT of (STRING, STRING, STRING, STRING, STRING, STRING,
 	// OBJECT_PATH, UINT32, STRING, OBJECT_PATH).
 	//
 	// Read the body starting from the array length "a" (uint32).
 	// The array length is in bytes, e.g., 35706 bytes.
-	if _, err = d.Dec.Uint32(); err != nil {
-		return fmt.Errorf("discard unit array length: %w", err)
+	var arrLen uint32
+	if arrLen, err = d.Dec.Uint32(); err != nil {
+		return 0, fmt.Errorf("decode unit array length: %w", err)
+	}
+	// The structs are aligned to an 8-byte boundary
+	// even if the array is empty.
+	if err = d.Dec.Align(8); err != nil {
+		return 0, fmt.Errorf("discard unit array padding: %w", err)
 	}
 
-	for {
-		err = decodeUnit(d.Dec, d.Conv, p, &d.unit)
-		switch err {
-		case nil:
-			f(&d.unit)
-		case errIgnore:
-		case io.EOF:
-			return nil
-		default:
-			return fmt.Errorf("message body: %w", err)
+	if err = d.checkArrayLen(arrLen); err != nil {
+		return 0, err
+	}
+
+	return d.Dec.Offset() + arrLen, nil
+}
+
+// unitConv returns the string converter of the unit fields,
+// see WithUnitBufferReuse.
+func (d *messageDecoder) unitConv() *stringConverter {
+	if d.UnitConv == nil {
+		return d.Conv
+	}
+
+	d.UnitConv.Reset()
+	return d.UnitConv
+}
+
+// checkArrayLen returns ErrTruncatedBody if the array of arrLen bytes
+// doesn't fit into the rest of the message body.
+func (d *messageDecoder) checkArrayLen(arrLen uint32) error {
+	if int64(arrLen) > d.bodyReader.N {
+		return fmt.Errorf("%w: array exceeded the message body: %d/%d bytes", ErrTruncatedBody, arrLen, d.bodyReader.N)
+	}
+	return nil
+}
+
+// checkArrayEnd returns ErrTruncatedBody if the last array element
+// was decoded past the array end, i.e., the array length cut it short.
+func (d *messageDecoder) checkArrayEnd(end uint32) error {
+	if offset := d.Dec.Offset(); offset != end {
+		return fmt.Errorf("%w: last array element ended at offset %d instead of %d", ErrTruncatedBody, offset, end)
+	}
+	return nil
+}
+
+// unitBodyError wraps the error of decoding a unit struct.
+// Running out of the body or connection data midway through the struct
+// means the final unit is truncated.
+func unitBodyError(err error) error {
+	if errors.Is(err, ErrBodyOverrun) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("message body: %w: %w", ErrTruncatedBody, err)
+	}
+	return fmt.Errorf("message body: %w", err)
+}
+
+// DecodeCountUnits decodes a reply from systemd ListUnits method
+// and returns the number of units which satisfy the predicate.
+// Unlike DecodeListUnits, the unit fields aren't converted to strings.
+func (d *messageDecoder) DecodeCountUnits(conn io.Reader, p Predicate) (int, error) {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return 0, err
+	}
+
+	end, err := d.decodeUnitArray()
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		count int
+		ok    bool
+	)
+	for n := 1; d.Dec.Offset() < end; n++ {
+		if err = d.Dec.checkArrayElements(n); err != nil {
+			return 0, err
+		}
+		if ok, err = countUnit(d.Dec, p); err != nil {
+			return 0, unitBodyError(err)
 		}
+		if ok {
+			count++
+		}
+	}
+	if err = d.checkArrayEnd(end); err != nil {
+		return 0, err
 	}
+
+	return count, d.finishBody()
 }
 
 type sentinelError string
@@ -211,7 +839,8 @@ const errIgnore = sentinelError("ignore")
 // In that case the unit would contain unusable data.
 //
 // Note, despite a predicate, all the struct fields are processed
-// to advance the decoder.
+// to advance the decoder, though the strings
+// following the filtered out field are skipped.
 func decodeUnit(d *decoder, conv *stringConverter, p Predicate, unit *Unit) error {
 	// The "()" symbols in the signature represent a STRUCT
 	// which is always aligned to an 8-byte boundary,
@@ -229,6 +858,13 @@ func decodeUnit(d *decoder, conv *stringConverter, p Predicate, unit *Unit) erro
 
 		switch field.Kind() {
 		case reflect.String:
+			if ignore {
+				if err := d.SkipString(); err != nil {
+					return err
+				}
+				continue
+			}
+
 			s, err := d.String()
 			if err != nil {
 				return err
@@ -258,41 +894,71 @@ func decodeUnit(d *decoder, conv *stringConverter, p Predicate, unit *Unit) erro
 	return nil
 }
 
-// DecodeMainPID decodes MainPID property reply from systemd
-// org.freedesktop.DBus.Properties.Get method.
-func (d *messageDecoder) DecodeMainPID(conn io.Reader) (uint32, error) {
-	d.Dec.Reset(conn)
+// unitSignature is the signature of the Unit struct.
+const unitSignature = "ssssssouso"
 
-	err := decodeHeader(d.Dec, d.Conv, &d.hdr, d.SkipHeaderFields)
-	if err != nil {
-		return 0, fmt.Errorf("message header: %w", err)
+// countUnit advances the decoder past D-Bus Unit struct
+// and reports whether the unit satisfies the predicate.
+// A nil predicate matches all the units.
+// Once the predicate rejected a field,
+// the remaining strings are discarded without being passed to it.
+func countUnit(d *decoder, p Predicate) (bool, error) {
+	// The Unit struct is aligned to an 8-byte boundary.
+	if err := d.Align(8); err != nil {
+		return false, err
 	}
 
-	d.bodyReader.R = conn
-	d.bodyReader.N = int64(d.hdr.BodyLen)
-	d.Dec.Reset(&d.bodyReader)
+	var (
+		ok  = true
+		s   []byte
+		err error
+	)
+	for i := 0; i < len(unitSignature); i++ {
+		if unitSignature[i] == typeUint32 {
+			if _, err = d.Uint32(); err != nil {
+				return false, err
+			}
+			continue
+		}
 
-	switch d.hdr.Type {
-	// Decode an error reply, e.g., invalid unit name.
-	case msgTypeError:
-		s, err := d.Dec.String()
-		if err != nil {
-			return 0, fmt.Errorf("decode error reply: %w", err)
+		// The string isn't needed, so it's skipped.
+		if p == nil || !ok {
+			if err = d.SkipString(); err != nil {
+				return false, err
+			}
+			continue
 		}
-		return 0, fmt.Errorf(d.Conv.String(s))
-	// Discard the signal that came before the expected reply,
-	// i.e., "name acquired" signal.
-	case msgTypeSignal:
-		if _, err = d.Dec.ReadN(d.hdr.BodyLen); err != nil {
-			return 0, fmt.Errorf("discard signal body: %w", err)
+
+		if s, err = d.String(); err != nil {
+			return false, err
 		}
-		// Decode the following message.
-		return d.DecodeMainPID(conn)
+		ok = p(i, s)
 	}
 
-	// Discard known signature "u".
-	if _, err = d.Dec.Signature(); err != nil {
-		return 0, fmt.Errorf("discard signature u: %w", err)
+	return ok, nil
+}
+
+// DecodeMainPID decodes MainPID property reply from systemd
+// org.freedesktop.DBus.Properties.Get method.
+// The property must be a UINT32 variant, otherwise an error is returned,
+// e.g., when a wrong interface was queried.
+func (d *messageDecoder) DecodeMainPID(conn io.Reader) (uint32, error) {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return 0, err
+	}
+
+	var sign []byte
+	if sign, err = d.Dec.Signature(); err != nil {
+		return 0, fmt.Errorf("decode variant signature: %w", err)
+	}
+	if len(sign) != 1 || sign[0] != typeUint32 {
+		err = fmt.Errorf("expected pid variant signature u, got %s", sign)
+		// The connection stays usable for the following calls.
+		if discardErr := d.discardBody(); discardErr != nil {
+			return 0, fmt.Errorf("discard body: %w", discardErr)
+		}
+		return 0, err
 	}
 
 	var pid uint32
@@ -300,99 +966,951 @@ func (d *messageDecoder) DecodeMainPID(conn io.Reader) (uint32, error) {
 		return 0, fmt.Errorf("decode pid: %w", err)
 	}
 
+	if err = d.finishBody(); err != nil {
+		return 0, fmt.Errorf("discard body: %w", err)
+	}
+
 	return pid, nil
 }
 
-func newMessageEncoder() *messageEncoder {
-	return &messageEncoder{
-		Enc:  newEncoder(nil),
-		Conv: newStringConverter(DefaultStringConverterSize),
+// DecodeUint32Property decodes a reply from
+// org.freedesktop.DBus.Properties.Get method
+// which contains a variant with UINT32 value.
+func (d *messageDecoder) DecodeUint32Property(conn io.Reader) (uint32, error) {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return 0, err
 	}
-}
 
-// messageEncoder is responsible for encoding and sending messages to dbus.
-type messageEncoder struct {
-	Enc  *encoder
-	Conv *stringConverter
+	var sign []byte
+	if sign, err = d.Dec.Signature(); err != nil {
+		return 0, fmt.Errorf("decode variant signature: %w", err)
+	}
+	if len(sign) != 1 || sign[0] != typeUint32 {
+		err = fmt.Errorf("expected variant signature u, got %s", sign)
+		// The connection stays usable for the following calls.
+		if discardErr := d.discardBody(); discardErr != nil {
+			return 0, fmt.Errorf("discard body: %w", discardErr)
+		}
+		return 0, err
+	}
 
-	// buf is a buffer where an encoder writes the message.
-	buf bytes.Buffer
+	var u uint32
+	if u, err = d.Dec.Uint32(); err != nil {
+		return 0, fmt.Errorf("decode uint32: %w", err)
+	}
+
+	if err = d.finishBody(); err != nil {
+		return 0, fmt.Errorf("discard body: %w", err)
+	}
+
+	return u, nil
 }
 
-// EncodeHello encodes a hello request.
-func (e *messageEncoder) EncodeHello(conn io.Writer, msgSerial uint32) error {
-	// Reset the encoder to encode the header.
-	e.buf.Reset()
-	e.Enc.Reset(&e.buf)
+// DecodeUint64Property decodes a reply from
+// org.freedesktop.DBus.Properties.Get method
+// which contains a variant with UINT64 value.
+func (d *messageDecoder) DecodeUint64Property(conn io.Reader) (uint64, error) {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return 0, err
+	}
 
-	h := header{
-		ByteOrder: littleEndian,
-		Type:      msgTypeMethodCall,
-		Proto:     1,
-		Serial:    msgSerial,
-		Fields: []headerField{
-			{Signature: "s", S: "org.freedesktop.DBus", Code: fieldDestination},
-			{Signature: "s", S: "Hello", Code: fieldMember},
-			{Signature: "s", S: "org.freedesktop.DBus", Code: fieldInterface},
-			{Signature: "o", S: "/org/freedesktop/DBus", Code: fieldPath},
-		},
+	var sign []byte
+	if sign, err = d.Dec.Signature(); err != nil {
+		return 0, fmt.Errorf("decode variant signature: %w", err)
 	}
-	err := encodeHeader(e.Enc, &h)
-	if err != nil {
-		return fmt.Errorf("message header: %w", err)
+	if len(sign) != 1 || sign[0] != typeUint64 {
+		err = fmt.Errorf("expected variant signature t, got %s", sign)
+		// The connection stays usable for the following calls.
+		if discardErr := d.discardBody(); discardErr != nil {
+			return 0, fmt.Errorf("discard body: %w", discardErr)
+		}
+		return 0, err
 	}
 
-	if _, err = conn.Write(e.buf.Bytes()); err != nil {
-		return fmt.Errorf("write message: %w", err)
+	var u uint64
+	if u, err = d.Dec.Uint64(); err != nil {
+		return 0, fmt.Errorf("decode uint64: %w", err)
 	}
 
-	return nil
+	if err = d.finishBody(); err != nil {
+		return 0, fmt.Errorf("discard body: %w", err)
+	}
+
+	return u, nil
 }
 
-// EncodeListUnits encodes a request to systemd ListUnits method.
-func (e *messageEncoder) EncodeListUnits(conn io.Writer, msgSerial uint32) error {
-	// Reset the encoder to encode the header.
-	e.buf.Reset()
-	e.Enc.Reset(&e.buf)
+// DecodeProperty decodes a reply from
+// org.freedesktop.DBus.Properties.Get method
+// which has the body signature "v".
+func (d *messageDecoder) DecodeProperty(conn io.Reader) (Variant, error) {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return Variant{}, err
+	}
 
-	h := header{
-		ByteOrder: littleEndian,
+	if err = d.Dec.Variant(d.Conv, &d.variant); err != nil {
+		return Variant{}, fmt.Errorf("decode property: %w", err)
+	}
+
+	if err = d.finishBody(); err != nil {
+		return Variant{}, fmt.Errorf("discard body: %w", err)
+	}
+
+	return d.variant, nil
+}
+
+// DecodeReplyValues decodes a reply of an arbitrary method
+// and returns its values in the order of the body signature,
+// e.g., two variants for the signature "so".
+// The values of unsupported types are returned undecoded, see RawVariant.
+// Note, SkipHeaderFields must be false,
+// because the body signature is kept in the header fields.
+func (d *messageDecoder) DecodeReplyValues(conn io.Reader) ([]Variant, error) {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		values []Variant
+		sig    = d.hdr.stringField(fieldSignature)
+		n      int
+	)
+	for sig != "" {
+		if n, err = typeLen(sig); err != nil {
+			return nil, fmt.Errorf("decode reply signature: %w", err)
+		}
+		values = append(values, Variant{})
+		if err = d.Dec.Value(d.Conv, sig[:n], &values[len(values)-1]); err != nil {
+			return nil, fmt.Errorf("decode reply value %d: %w", len(values)-1, err)
+		}
+		sig = sig[n:]
+	}
+
+	if err = d.finishBody(); err != nil {
+		return nil, fmt.Errorf("discard body: %w", err)
+	}
+
+	return values, nil
+}
+
+// DecodeEachProperty decodes a reply from
+// org.freedesktop.DBus.Properties.GetAll method
+// which has the body signature "a{sv}",
+// and calls f for each property until f returns false.
+// The remaining properties are discarded without decoding.
+func (d *messageDecoder) DecodeEachProperty(conn io.Reader, f func(name string, v Variant) bool) error {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return err
+	}
+
+	// The array length is in bytes
+	// and it doesn't include the padding before the first dict entry.
+	var arrLen uint32
+	if arrLen, err = d.Dec.Uint32(); err != nil {
+		return fmt.Errorf("decode property array length: %w", err)
+	}
+	// Dict entries are always aligned to an 8-byte boundary
+	// similar to structs, even if the array is empty.
+	if err = d.Dec.Align(8); err != nil {
+		return fmt.Errorf("discard property array padding: %w", err)
+	}
+
+	var (
+		end      = d.Dec.Offset() + arrLen
+		name     []byte
+		propName string
+	)
+	for n := 1; d.Dec.Offset() < end; n++ {
+		if err = d.Dec.checkArrayElements(n); err != nil {
+			return err
+		}
+		if err = d.Dec.Align(8); err != nil {
+			return fmt.Errorf("discard property padding: %w", err)
+		}
+		if name, err = d.Dec.String(); err != nil {
+			return fmt.Errorf("decode property name: %w", err)
+		}
+		// The name must be converted before the variant is decoded,
+		// because the name bytes are overwritten by the next read.
+		propName = d.Conv.String(name)
+		if err = d.Dec.Variant(d.Conv, &d.variant); err != nil {
+			return fmt.Errorf("decode property %s: %w", propName, err)
+		}
+
+		if !f(propName, d.variant) {
+			break
+		}
+	}
+
+	// Discard the properties which weren't decoded.
+	if err = d.discardBody(); err != nil {
+		return fmt.Errorf("discard properties: %w", err)
+	}
+
+	return nil
+}
+
+// eachRawProperty decodes the undecoded "a{sv}" variant,
+// e.g., the changed properties of PropertiesChanged signal,
+// and calls f for each property until f returns false.
+// The dict entries are 8-byte aligned, so raw.Offset is always zero,
+// and the bytes are decoded as little-endian.
+func eachRawProperty(raw *RawVariant, f func(name string, v Variant) bool) error {
+	if raw.Signature != "a{sv}" {
+		return fmt.Errorf("expected a{sv} properties got %s", raw.Signature)
+	}
+
+	var (
+		d    = newDecoder(bytes.NewReader(raw.Bytes))
+		conv = newStringConverter(len(raw.Bytes))
+		end  = uint32(len(raw.Bytes))
+		v    Variant
+	)
+	for d.Offset() < end {
+		if err := d.Align(8); err != nil {
+			return fmt.Errorf("discard property padding: %w", err)
+		}
+		name, err := d.String()
+		if err != nil {
+			return fmt.Errorf("decode property name: %w", err)
+		}
+		// The name must be converted before the variant is decoded,
+		// because the name bytes are overwritten by the next read.
+		propName := conv.String(name)
+		if err = d.Variant(conv, &v); err != nil {
+			return fmt.Errorf("decode property %s: %w", propName, err)
+		}
+
+		if !f(propName, v) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// DecodeEmptyReply decodes a reply which has no body,
+// e.g., a reply from org.freedesktop.DBus.Peer.Ping method.
+func (d *messageDecoder) DecodeEmptyReply(conn io.Reader) error {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return err
+	}
+
+	// Discard the unexpected body if there is any.
+	if err = d.finishBody(); err != nil {
+		return fmt.Errorf("discard body: %w", err)
+	}
+
+	return nil
+}
+
+// DecodeObjectPath decodes a reply with the body signature "o",
+// e.g., a job object path returned from systemd StartUnit method.
+func (d *messageDecoder) DecodeObjectPath(conn io.Reader) (string, error) {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return "", err
+	}
+
+	var path []byte
+	if path, err = d.Dec.String(); err != nil {
+		return "", fmt.Errorf("decode object path: %w", err)
+	}
+
+	if err = d.finishBody(); err != nil {
+		return "", fmt.Errorf("discard body: %w", err)
+	}
+
+	return d.Conv.String(path), nil
+}
+
+// DecodeString decodes a reply with the body signature "s",
+// e.g., a unit file state returned from systemd GetUnitFileState method.
+func (d *messageDecoder) DecodeString(conn io.Reader) (string, error) {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return "", err
+	}
+
+	var s []byte
+	if s, err = d.Dec.String(); err != nil {
+		return "", fmt.Errorf("decode string: %w", err)
+	}
+
+	if err = d.finishBody(); err != nil {
+		return "", fmt.Errorf("discard body: %w", err)
+	}
+
+	return d.Conv.String(s), nil
+}
+
+// DecodeUnitProcesses decodes a reply from systemd GetUnitProcesses method
+// which has the body signature "a(sus)", i.e.,
+// an array of the control group path, PID, and command line of each process.
+func (d *messageDecoder) DecodeUnitProcesses(conn io.Reader) ([]UnitProcess, error) {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var arrLen uint32
+	if arrLen, err = d.Dec.Uint32(); err != nil {
+		return nil, fmt.Errorf("decode process array length: %w", err)
+	}
+	// The structs are aligned to an 8-byte boundary
+	// even if the array is empty.
+	if err = d.Dec.Align(8); err != nil {
+		return nil, fmt.Errorf("discard process array padding: %w", err)
+	}
+
+	var (
+		procs []UnitProcess
+		p     UnitProcess
+		// The fields are decoded in the struct's order:
+		// cgroup path, PID, and command line.
+		fields = []any{&p.CgroupPath, &p.PID, &p.Command}
+	)
+	for end, n := d.Dec.Offset()+arrLen, 1; d.Dec.Offset() < end; n++ {
+		if err = d.Dec.checkArrayElements(n); err != nil {
+			return nil, err
+		}
+		if err = d.Dec.Struct(d.Conv, "(sus)", fields); err != nil {
+			return nil, fmt.Errorf("decode process: %w", err)
+		}
+
+		procs = append(procs, p)
+	}
+
+	if err = d.finishBody(); err != nil {
+		return nil, fmt.Errorf("discard body: %w", err)
+	}
+
+	return procs, nil
+}
+
+// DecodeListSessions decodes a reply from logind ListSessions method
+// which has the body signature "a(susso)", i.e.,
+// an array of the session ID, user ID, user name, seat ID,
+// and session object path.
+func (d *messageDecoder) DecodeListSessions(conn io.Reader) ([]Session, error) {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var arrLen uint32
+	if arrLen, err = d.Dec.Uint32(); err != nil {
+		return nil, fmt.Errorf("decode session array length: %w", err)
+	}
+	if err = d.Dec.Align(8); err != nil {
+		return nil, fmt.Errorf("discard session array padding: %w", err)
+	}
+
+	var (
+		sessions []Session
+		s        Session
+		b        []byte
+	)
+	for end, n := d.Dec.Offset()+arrLen, 1; d.Dec.Offset() < end; n++ {
+		if err = d.Dec.checkArrayElements(n); err != nil {
+			return nil, err
+		}
+		if err = d.Dec.Align(8); err != nil {
+			return nil, fmt.Errorf("discard session padding: %w", err)
+		}
+		if b, err = d.Dec.String(); err != nil {
+			return nil, fmt.Errorf("decode session id: %w", err)
+		}
+		s.ID = d.Conv.String(b)
+		if s.UID, err = d.Dec.Uint32(); err != nil {
+			return nil, fmt.Errorf("decode session uid: %w", err)
+		}
+		if b, err = d.Dec.String(); err != nil {
+			return nil, fmt.Errorf("decode session user: %w", err)
+		}
+		s.User = d.Conv.String(b)
+		if b, err = d.Dec.String(); err != nil {
+			return nil, fmt.Errorf("decode session seat: %w", err)
+		}
+		s.Seat = d.Conv.String(b)
+		if b, err = d.Dec.String(); err != nil {
+			return nil, fmt.Errorf("decode session path: %w", err)
+		}
+		s.Path = d.Conv.String(b)
+
+		sessions = append(sessions, s)
+	}
+
+	if err = d.finishBody(); err != nil {
+		return nil, fmt.Errorf("discard body: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// DecodeListUnitFiles decodes a reply from systemd ListUnitFiles method
+// which has the body signature "a(ss)", i.e.,
+// the unit file path and its enablement state.
+// The unit files are passed to f one by one.
+func (d *messageDecoder) DecodeListUnitFiles(conn io.Reader, f func(*UnitFile)) error {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return err
+	}
+
+	var arrLen uint32
+	if arrLen, err = d.Dec.Uint32(); err != nil {
+		return fmt.Errorf("decode unit file array length: %w", err)
+	}
+	if err = d.Dec.Align(8); err != nil {
+		return fmt.Errorf("discard unit file array padding: %w", err)
+	}
+
+	var (
+		uf     UnitFile
+		fields = []any{&uf.Path, &uf.State}
+	)
+	for end, n := d.Dec.Offset()+arrLen, 1; d.Dec.Offset() < end; n++ {
+		if err = d.Dec.checkArrayElements(n); err != nil {
+			return err
+		}
+		if err = d.Dec.Struct(d.Conv, "(ss)", fields); err != nil {
+			return fmt.Errorf("decode unit file: %w", err)
+		}
+
+		f(&uf)
+	}
+
+	if err = d.finishBody(); err != nil {
+		return fmt.Errorf("discard body: %w", err)
+	}
+
+	return nil
+}
+
+// DecodeEnableUnitFiles decodes a reply from systemd EnableUnitFiles method
+// which has the body signature "ba(sss)", i.e.,
+// whether the unit files carry the [Install] section,
+// and the changes made, see decodeUnitFileChanges.
+func (d *messageDecoder) DecodeEnableUnitFiles(conn io.Reader) (carriesInstallInfo bool, changes []UnitFileChange, err error) {
+	if err = d.decodeReply(conn); err != nil {
+		return false, nil, err
+	}
+
+	var b uint32
+	if b, err = d.Dec.Uint32(); err != nil {
+		return false, nil, fmt.Errorf("decode carries install info: %w", err)
+	}
+	if changes, err = d.decodeUnitFileChanges(); err != nil {
+		return false, nil, err
+	}
+
+	return b != 0, changes, nil
+}
+
+// DecodeUnitFileChanges decodes a reply from one of systemd methods
+// that change the unit files, e.g., DisableUnitFiles,
+// which has the body signature "a(sss)", see decodeUnitFileChanges.
+func (d *messageDecoder) DecodeUnitFileChanges(conn io.Reader) ([]UnitFileChange, error) {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.decodeUnitFileChanges()
+}
+
+// decodeUnitFileChanges decodes the rest of the message body
+// as an array "a(sss)" of the unit file changes, i.e.,
+// the type of the change, the file name of the symlink, and its destination.
+func (d *messageDecoder) decodeUnitFileChanges() ([]UnitFileChange, error) {
+	arrLen, err := d.Dec.Uint32()
+	if err != nil {
+		return nil, fmt.Errorf("decode unit file change array length: %w", err)
+	}
+	if err = d.Dec.Align(8); err != nil {
+		return nil, fmt.Errorf("discard unit file change array padding: %w", err)
+	}
+
+	var (
+		changes []UnitFileChange
+		ch      UnitFileChange
+		b       []byte
+	)
+	for end, n := d.Dec.Offset()+arrLen, 1; d.Dec.Offset() < end; n++ {
+		if err = d.Dec.checkArrayElements(n); err != nil {
+			return nil, err
+		}
+		if err = d.Dec.Align(8); err != nil {
+			return nil, fmt.Errorf("discard unit file change padding: %w", err)
+		}
+		if b, err = d.Dec.String(); err != nil {
+			return nil, fmt.Errorf("decode unit file change type: %w", err)
+		}
+		ch.Type = d.Conv.String(b)
+		if b, err = d.Dec.String(); err != nil {
+			return nil, fmt.Errorf("decode unit file change file name: %w", err)
+		}
+		ch.Filename = d.Conv.String(b)
+		if b, err = d.Dec.String(); err != nil {
+			return nil, fmt.Errorf("decode unit file change destination: %w", err)
+		}
+		ch.Destination = d.Conv.String(b)
+
+		changes = append(changes, ch)
+	}
+
+	if err = d.finishBody(); err != nil {
+		return nil, fmt.Errorf("discard body: %w", err)
+	}
+
+	return changes, nil
+}
+
+// DecodeGetUnitByPIDFD decodes a reply from systemd GetUnitByPIDFD method
+// which has the body signature "osay", i.e.,
+// the unit object path, the unit name, and the invocation ID
+// which is returned as a hex string.
+func (d *messageDecoder) DecodeGetUnitByPIDFD(conn io.Reader) (path, unitID, invocationID string, err error) {
+	if err = d.decodeReply(conn); err != nil {
+		return "", "", "", err
+	}
+
+	var b []byte
+	if b, err = d.Dec.String(); err != nil {
+		return "", "", "", fmt.Errorf("decode unit path: %w", err)
+	}
+	path = d.Conv.String(b)
+
+	if b, err = d.Dec.String(); err != nil {
+		return "", "", "", fmt.Errorf("decode unit name: %w", err)
+	}
+	unitID = d.Conv.String(b)
+
+	// The invocation ID is an array of bytes "ay",
+	// i.e., the array length followed by 16 bytes of ID.
+	var n uint32
+	if n, err = d.Dec.Uint32(); err != nil {
+		return "", "", "", fmt.Errorf("decode invocation ID length: %w", err)
+	}
+	if b, err = d.Dec.ReadN(n); err != nil {
+		return "", "", "", fmt.Errorf("decode invocation ID: %w", err)
+	}
+	invocationID = hex.EncodeToString(b)
+
+	if err = d.finishBody(); err != nil {
+		return "", "", "", fmt.Errorf("discard body: %w", err)
+	}
+
+	return path, unitID, invocationID, nil
+}
+
+// DecodeUnixFD decodes a reply with the body signature "h"
+// and returns the received file descriptor,
+// e.g., a memfd returned from systemd DumpByFileDescriptor method.
+// The caller is responsible for closing the file descriptor.
+func (d *messageDecoder) DecodeUnixFD(conn io.Reader) (int, error) {
+	err := d.decodeReply(conn)
+	if err != nil {
+		return 0, err
+	}
+
+	var fd int
+	if fd, err = d.Dec.UnixFD(); err != nil {
+		return 0, fmt.Errorf("decode unix fd: %w", err)
+	}
+
+	if err = d.finishBody(); err != nil {
+		// The caller won't get the claimed file descriptor, so it's closed here.
+		os.NewFile(uintptr(fd), "").Close()
+		return 0, fmt.Errorf("discard body: %w", err)
+	}
+
+	return fd, nil
+}
+
+// DecodeSignal decodes the next signal from conn into s.
+// The method replies and errors that came before the signal are discarded.
+// Note, SkipHeaderFields must be false,
+// otherwise the signal's member and arguments are unknown.
+func (d *messageDecoder) DecodeSignal(conn io.Reader, s *Signal) error {
+	for {
+		d.Dec.Reset(conn)
+
+		err := decodeHeader(d.Dec, d.Conv, &d.hdr, d.SkipHeaderFields)
+		if err != nil {
+			return fmt.Errorf("message header: %w", err)
+		}
+		if err = d.checkHeader(); err != nil {
+			return err
+		}
+
+		d.bodyReader.R = conn
+		d.bodyReader.N = int64(d.hdr.BodyLen)
+		d.Dec.SetSource(&d.bodyReader)
+		d.Dec.SetLimit(d.hdr.BodyLen)
+		if d.UnixFDs != nil {
+			d.Dec.SetUnixFDs(*d.UnixFDs)
+		}
+
+		if d.hdr.Type == msgTypeSignal {
+			break
+		}
+		if err = d.discardBody(); err != nil {
+			return fmt.Errorf("discard message body: %w", err)
+		}
+	}
+
+	*s = Signal{
+		Sender:    d.hdr.stringField(fieldSender),
+		Path:      d.hdr.stringField(fieldPath),
+		Interface: d.hdr.stringField(fieldInterface),
+		Member:    d.hdr.stringField(fieldMember),
+		Signature: d.hdr.stringField(fieldSignature),
+	}
+
+	// Each single complete type of the signature is a separate argument,
+	// e.g., "so" is a string followed by an object path.
+	for sig := s.Signature; sig != ""; {
+		n, err := typeLen(sig)
+		if err != nil {
+			return fmt.Errorf("signal signature %q: %w", s.Signature, err)
+		}
+
+		var v Variant
+		if err = d.Dec.Value(d.Conv, sig[:n], &v); err != nil {
+			return fmt.Errorf("decode signal argument %q: %w", sig[:n], err)
+		}
+		s.Args = append(s.Args, v)
+		sig = sig[n:]
+	}
+
+	if err := d.finishBody(); err != nil {
+		return fmt.Errorf("discard body: %w", err)
+	}
+
+	return nil
+}
+
+func newMessageEncoder() *messageEncoder {
+	return &messageEncoder{
+		Enc:  newEncoder(nil),
+		Conv: newStringConverter(DefaultStringConverterSize),
+	}
+}
+
+// messageEncoder is responsible for encoding and sending messages to dbus.
+type messageEncoder struct {
+	Enc  *encoder
+	Conv *stringConverter
+	// Flags is a bitwise OR of message flags
+	// which are set in the header of encoded messages.
+	Flags byte
+	// Destination overrides the destination header field
+	// of encoded messages if set.
+	Destination string
+	// StreamSize enables writing of the message into the connection
+	// in chunks of that size as it's being encoded,
+	// instead of buffering the whole message, see encodeStream.
+	// Zero means the message is buffered.
+	StreamSize int
+
+	// buf is a buffer where an encoder writes the message.
+	buf bytes.Buffer
+	// fds are Unix file descriptors that accompany the message being encoded.
+	// They are sent out-of-band, and the header field fieldUnixFDs
+	// must contain their number.
+	fds []int
+}
+
+// encode encodes a message with the header h and writes it to conn.
+// The message body is encoded by encodeBody func unless it's nil,
+// and then h.BodyLen is overwritten with an actual length of the body.
+func (e *messageEncoder) encode(conn io.Writer, h *header, encodeBody func(enc *encoder)) error {
+	// The file descriptors accompany only this message
+	// even if it fails to be encoded or written.
+	defer func() {
+		e.fds = e.fds[:0]
+	}()
+
+	// The messages with file descriptors are small,
+	// and they must be written at once along with the descriptors.
+	if e.StreamSize > 0 && encodeBody != nil && len(e.fds) == 0 {
+		return e.encodeStream(conn, h, encodeBody)
+	}
+
+	// Reset the encoder to encode the header and the body.
+	e.buf.Reset()
+	e.Enc.Reset(&e.buf)
+
+	h.Flags |= e.Flags
+	e.overrideDestination(h)
+	err := encodeHeader(e.Enc, h)
+	if err != nil {
+		return fmt.Errorf("message header: %w", err)
+	}
+
+	if encodeBody != nil {
+		bodyOffset := e.Enc.Offset()
+		encodeBody(e.Enc)
+
+		// Overwrite the h.BodyLen with an actual length of the message body.
+		const headerBodyLenOffset = 4
+		bodyLen := e.Enc.Offset() - bodyOffset
+		if err = e.Enc.Uint32At(bodyLen, headerBodyLenOffset); err != nil {
+			return fmt.Errorf("encode header BodyLen: %w", err)
+		}
+	}
+
+	// The file descriptors can be passed only over a Unix domain socket.
+	if len(e.fds) > 0 {
+		uc, ok := conn.(*net.UnixConn)
+		if !ok {
+			return fmt.Errorf("connection doesn't support unix fd passing")
+		}
+		if err = writeMessageWithFDs(uc, e.buf.Bytes(), e.fds); err != nil {
+			return fmt.Errorf("write message: %w", err)
+		}
+
+		return nil
+	}
+
+	if _, err = conn.Write(e.buf.Bytes()); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+
+	return nil
+}
+
+// encodeStream encodes a message similar to encode,
+// but the message is written into conn in chunks of StreamSize bytes,
+// so a large body doesn't double the memory usage.
+// That takes two passes over the body:
+// the first one computes the body length which the header must contain,
+// and the second one writes the body.
+func (e *messageEncoder) encodeStream(conn io.Writer, h *header, encodeBody func(enc *encoder)) error {
+	// The body starts on an 8-byte boundary,
+	// so it's aligned the same way starting from zero offset.
+	e.buf.Reset()
+	e.Enc.Reset(&e.buf)
+	e.Enc.SetFlushWriter(io.Discard, e.StreamSize)
+	encodeBody(e.Enc)
+	h.BodyLen = e.Enc.Offset()
+
+	e.buf.Reset()
+	e.Enc.Reset(&e.buf)
+	h.Flags |= e.Flags
+	e.overrideDestination(h)
+	// The header is buffered because its FieldsLen is overwritten.
+	err := encodeHeader(e.Enc, h)
+	if err != nil {
+		return fmt.Errorf("message header: %w", err)
+	}
+
+	e.Enc.SetFlushWriter(conn, e.StreamSize)
+	encodeBody(e.Enc)
+	if err = e.Enc.Flush(); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+
+	return nil
+}
+
+// overrideDestination replaces the destination header field with e.Destination if set.
+func (e *messageEncoder) overrideDestination(h *header) {
+	if e.Destination == "" {
+		return
+	}
+	for i := range h.Fields {
+		if h.Fields[i].Code == fieldDestination {
+			h.Fields[i].S = e.Destination
+		}
+	}
+}
+
+// encodeManagerCall encodes a request to the systemd Manager's method
+// which has no arguments, e.g., Reload.
+func (e *messageEncoder) encodeManagerCall(conn io.Writer, member string, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: member, Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+		},
+	}
+	return e.encode(conn, &h, nil)
+}
+
+// EncodeHello encodes a hello request.
+func (e *messageEncoder) EncodeHello(conn io.Writer, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
 		Type:      msgTypeMethodCall,
 		Proto:     1,
 		Serial:    msgSerial,
 		Fields: []headerField{
-			{Signature: "s", S: "ListUnits", Code: fieldMember},
-			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
-			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
-			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+			{Signature: "s", S: "org.freedesktop.DBus", Code: fieldDestination},
+			{Signature: "s", S: "Hello", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.DBus", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/DBus", Code: fieldPath},
 		},
 	}
-	err := encodeHeader(e.Enc, &h)
-	if err != nil {
-		return fmt.Errorf("message header: %w", err)
+	return e.encode(conn, &h, nil)
+}
+
+// EncodePing encodes a request to org.freedesktop.DBus.Peer.Ping method
+// of the message bus.
+func (e *messageEncoder) EncodePing(conn io.Writer, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: "org.freedesktop.DBus", Code: fieldDestination},
+			{Signature: "s", S: "Ping", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.DBus.Peer", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/DBus", Code: fieldPath},
+		},
 	}
+	return e.encode(conn, &h, nil)
+}
 
-	if _, err = conn.Write(e.buf.Bytes()); err != nil {
-		return fmt.Errorf("write message: %w", err)
+// EncodeAddMatch encodes a request to org.freedesktop.DBus.AddMatch method
+// which subscribes the connection to the messages matching the rule,
+// e.g., "type='signal',sender='org.freedesktop.systemd1'".
+func (e *messageEncoder) EncodeAddMatch(conn io.Writer, rule string, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: "org.freedesktop.DBus", Code: fieldDestination},
+			{Signature: "s", S: "AddMatch", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.DBus", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/DBus", Code: fieldPath},
+			{Signature: "g", S: "s", Code: fieldSignature},
+		},
 	}
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.String(rule)
+	})
+}
 
-	return nil
+// EncodeSubscribe encodes a request to systemd Subscribe method
+// which enables the Manager's signals such as UnitNew and JobRemoved.
+func (e *messageEncoder) EncodeSubscribe(conn io.Writer, msgSerial uint32) error {
+	return e.encodeManagerCall(conn, "Subscribe", msgSerial)
+}
+
+// EncodeReload encodes a request to systemd Reload method.
+func (e *messageEncoder) EncodeReload(conn io.Writer, msgSerial uint32) error {
+	return e.encodeManagerCall(conn, "Reload", msgSerial)
+}
+
+// EncodeListUnits encodes a request to systemd ListUnits method.
+func (e *messageEncoder) EncodeListUnits(conn io.Writer, msgSerial uint32) error {
+	return e.encodeManagerCall(conn, "ListUnits", msgSerial)
+}
+
+// EncodeListUnitFiles encodes a request to systemd ListUnitFiles method.
+func (e *messageEncoder) EncodeListUnitFiles(conn io.Writer, msgSerial uint32) error {
+	return e.encodeManagerCall(conn, "ListUnitFiles", msgSerial)
+}
+
+// EncodeListUnitsByPatterns encodes a request to systemd ListUnitsByPatterns method
+// which returns the units matching the given states and name patterns.
+// Empty states or patterns match all the units.
+// Note, nil slices are encoded as empty arrays (zero length),
+// because both arguments must be present in the call.
+func (e *messageEncoder) EncodeListUnitsByPatterns(conn io.Writer, states, patterns []string, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: "ListUnitsByPatterns", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+			{Signature: "g", S: "asas", Code: fieldSignature},
+		},
+	}
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.StringArray(states)
+		enc.StringArray(patterns)
+	})
 }
 
 // EncodeMainPID encodes MainPID property request for the given unit name,
 // e.g., "dbus.service".
 func (e *messageEncoder) EncodeMainPID(conn io.Writer, unitName string, msgSerial uint32) error {
-	// Escape an object path to send a call to,
-	// e.g., /org/freedesktop/systemd1/unit/dbus_2eservice.
+	return e.EncodeGetProperty(conn, unitName, "org.freedesktop.systemd1.Service", "MainPID", msgSerial)
+}
+
+// EncodeGetProperty encodes a request to org.freedesktop.DBus.Properties.Get method
+// to retrieve the property propName of the interface iface
+// from the given unit, e.g., "dbus.service".
+func (e *messageEncoder) EncodeGetProperty(conn io.Writer, unitName, iface, propName string, msgSerial uint32) error {
+	return e.EncodeGetObjectProperty(conn, e.unitPath(unitName), iface, propName, msgSerial)
+}
+
+// EncodeGetObjectProperty encodes a request to org.freedesktop.DBus.Properties.Get method
+// to retrieve the property propName of the interface iface
+// from the object objPath, e.g., "/org/freedesktop/systemd1".
+func (e *messageEncoder) EncodeGetObjectProperty(conn io.Writer, objPath, iface, propName string, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "o", S: objPath, Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+			{Signature: "s", S: "Get", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.DBus.Properties", Code: fieldInterface},
+			{Signature: "g", S: "ss", Code: fieldSignature},
+		},
+	}
+	// Encode message body with a known signature "ss".
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.String(iface)
+		enc.String(propName)
+	})
+}
+
+// EncodeGetAllUnitProperties encodes a request to org.freedesktop.DBus.Properties.GetAll method
+// to retrieve all the properties of the interface iface
+// from the given unit, e.g., "dbus.service".
+func (e *messageEncoder) EncodeGetAllUnitProperties(conn io.Writer, unitName, iface string, msgSerial uint32) error {
+	return e.EncodeGetAllProperties(conn, e.unitPath(unitName), iface, msgSerial)
+}
+
+// unitPath returns an escaped object path of the unit,
+// e.g., /org/freedesktop/systemd1/unit/dbus_2eservice.
+func (e *messageEncoder) unitPath(unitName string) string {
 	e.buf.Reset()
 	e.buf.WriteString("/org/freedesktop/systemd1/unit/")
 	escapeBusLabel(unitName, &e.buf)
-	objPath := e.Conv.String(e.buf.Bytes())
-
-	// Reset the encoder to encode the header and the body.
-	e.buf.Reset()
-	e.Enc.Reset(&e.buf)
+	return e.Conv.String(e.buf.Bytes())
+}
 
+// EncodeGetAllProperties encodes a request to org.freedesktop.DBus.Properties.GetAll method
+// to retrieve all the properties of the interface iface from the object objPath,
+// e.g., "/org/freedesktop/systemd1/unit/dbus_2eservice".
+func (e *messageEncoder) EncodeGetAllProperties(conn io.Writer, objPath, iface string, msgSerial uint32) error {
 	h := header{
 		ByteOrder: littleEndian,
 		Type:      msgTypeMethodCall,
@@ -401,35 +1919,339 @@ func (e *messageEncoder) EncodeMainPID(conn io.Writer, unitName string, msgSeria
 		Fields: []headerField{
 			{Signature: "o", S: objPath, Code: fieldPath},
 			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
-			{Signature: "s", S: "Get", Code: fieldMember},
+			{Signature: "s", S: "GetAll", Code: fieldMember},
 			{Signature: "s", S: "org.freedesktop.DBus.Properties", Code: fieldInterface},
+			{Signature: "g", S: "s", Code: fieldSignature},
+		},
+	}
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.String(iface)
+	})
+}
+
+// EncodeUnitJob encodes a request to one of systemd methods
+// that enqueue a job for the unit, e.g., StartUnit, StopUnit, RestartUnit.
+// All of them have the same body signature "ss",
+// i.e., the unit name such as "dbus.service" and the job mode such as "replace".
+func (e *messageEncoder) EncodeUnitJob(conn io.Writer, member, unitName, mode string, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: member, Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
 			{Signature: "g", S: "ss", Code: fieldSignature},
 		},
 	}
-	err := encodeHeader(e.Enc, &h)
-	if err != nil {
-		return fmt.Errorf("message header: %w", err)
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.String(unitName)
+		enc.String(mode)
+	})
+}
+
+// EncodeKillUnit encodes a request to systemd KillUnit method
+// which has the body signature "ssi", i.e.,
+// the unit name, the processes to kill, and the signal number.
+func (e *messageEncoder) EncodeKillUnit(conn io.Writer, unitName, who string, signal int32, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: "KillUnit", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+			{Signature: "g", S: "ssi", Code: fieldSignature},
+		},
 	}
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.String(unitName)
+		enc.String(who)
+		enc.Uint32(uint32(signal))
+	})
+}
 
-	// Encode message body with a known signature "ss".
-	const (
-		iface    = "org.freedesktop.systemd1.Service"
-		propName = "MainPID"
-	)
-	bodyOffset := e.Enc.Offset()
-	e.Enc.String(iface)
-	e.Enc.String(propName)
+// EncodeSetUnitProperty encodes a request to systemd SetUnitProperties method
+// which has the body signature "sba(sv)", i.e., the unit name,
+// whether the change is runtime only, and the properties to set.
+// Here the array holds the single property propName with the given value
+// which must be checked with checkVariant beforehand.
+func (e *messageEncoder) EncodeSetUnitProperty(conn io.Writer, unitName string, runtime bool, propName string, value Variant, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: "SetUnitProperties", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+			{Signature: "g", S: "sba(sv)", Code: fieldSignature},
+		},
+	}
+	// The array length is computed beforehand
+	// instead of being overwritten after the element is encoded,
+	// because the body might be already written, see encodeStream.
+	// The struct starts on an 8-byte boundary,
+	// so it's aligned the same way starting from zero offset.
+	var elem bytes.Buffer
+	elemEnc := newEncoder(&elem)
+	elemEnc.String(propName)
+	elemEnc.Variant(value)
+	arrLen := elemEnc.Offset()
+	if arrLen > maxArrayLen {
+		return fmt.Errorf("array exceeded the maximum length: %d/%d bytes", arrLen, maxArrayLen)
+	}
 
-	// Overwrite the h.BodyLen with an actual length of the message body.
-	const headerBodyLenOffset = 4
-	bodyLen := e.Enc.Offset() - bodyOffset
-	if err = e.Enc.Uint32At(bodyLen, headerBodyLenOffset); err != nil {
-		return fmt.Errorf("encode header BodyLen: %w", err)
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.String(unitName)
+		enc.Uint32(boolToUint32(runtime))
+
+		enc.Uint32(arrLen)
+		enc.Align(8)
+		enc.String(propName)
+		enc.Variant(value)
+	})
+}
+
+// EncodeEnvironment encodes a request to one of systemd methods
+// that modify the manager environment, e.g., SetEnvironment, UnsetEnvironment.
+// Both of them have the same body signature "as",
+// i.e., the variable assignments such as "FOO=bar", or the variable names to unset.
+func (e *messageEncoder) EncodeEnvironment(conn io.Writer, member string, env []string, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: member, Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+			{Signature: "g", S: "as", Code: fieldSignature},
+		},
 	}
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.StringArray(env)
+	})
+}
 
-	if _, err = conn.Write(e.buf.Bytes()); err != nil {
-		return fmt.Errorf("write message: %w", err)
+// EncodeDump encodes a request to one of systemd methods
+// that dump the manager state, i.e., Dump and DumpByFileDescriptor.
+// Both of them have no arguments.
+func (e *messageEncoder) EncodeDump(conn io.Writer, member string, msgSerial uint32) error {
+	return e.encodeManagerCall(conn, member, msgSerial)
+}
+
+// EncodeIntrospect encodes a request to org.freedesktop.DBus.Introspectable.Introspect method
+// which returns the XML description of the object objPath,
+// e.g., "/org/freedesktop/systemd1/unit/dbus_2eservice".
+func (e *messageEncoder) EncodeIntrospect(conn io.Writer, objPath string, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: "Introspect", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.DBus.Introspectable", Code: fieldInterface},
+			{Signature: "o", S: objPath, Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+		},
+	}
+	return e.encode(conn, &h, nil)
+}
+
+// EncodeMethodCall encodes a request to the method member of the interface iface
+// implemented by the object path of the D-Bus service dest,
+// e.g., org.freedesktop.login1.
+// The body consists of the args whose signatures make up the body signature,
+// so they must be checked with checkVariant beforehand.
+func (e *messageEncoder) EncodeMethodCall(conn io.Writer, dest, path, iface, member string, args []Variant, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: member, Code: fieldMember},
+			{Signature: "s", S: iface, Code: fieldInterface},
+			{Signature: "o", S: path, Code: fieldPath},
+			{Signature: "s", S: dest, Code: fieldDestination},
+		},
+	}
+	if len(args) == 0 {
+		return e.encode(conn, &h, nil)
 	}
 
-	return nil
+	var sig string
+	for _, a := range args {
+		sig += a.Signature
+	}
+	if len(sig) > maxSignatureLen {
+		return fmt.Errorf("signature exceeded the maximum length: %d/%d bytes", len(sig), maxSignatureLen)
+	}
+	h.Fields = append(h.Fields, headerField{Signature: "g", S: sig, Code: fieldSignature})
+
+	return e.encode(conn, &h, func(enc *encoder) {
+		for _, a := range args {
+			enc.Value(a)
+		}
+	})
+}
+
+// EncodeGetUnit encodes a request to systemd GetUnit method
+// which returns the object path of the loaded unit.
+// The body signature is "s", i.e., the unit name such as "dbus.service",
+// or its alias.
+func (e *messageEncoder) EncodeGetUnit(conn io.Writer, unitName string, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: "GetUnit", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+			{Signature: "g", S: "s", Code: fieldSignature},
+		},
+	}
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.String(unitName)
+	})
+}
+
+// EncodeGetUnitFileState encodes a request to systemd GetUnitFileState method.
+// The body signature is "s", i.e., the unit file name such as "dbus.service".
+func (e *messageEncoder) EncodeGetUnitFileState(conn io.Writer, file string, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: "GetUnitFileState", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+			{Signature: "g", S: "s", Code: fieldSignature},
+		},
+	}
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.String(file)
+	})
+}
+
+// EncodeEnableUnitFiles encodes a request to systemd EnableUnitFiles method
+// which has the body signature "asbb", i.e., the unit files such as "ssh.service",
+// whether to enable them only until the next reboot,
+// and whether to replace the conflicting symlinks.
+func (e *messageEncoder) EncodeEnableUnitFiles(conn io.Writer, files []string, runtime, force bool, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: "EnableUnitFiles", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+			{Signature: "g", S: "asbb", Code: fieldSignature},
+		},
+	}
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.StringArray(files)
+		enc.Uint32(boolToUint32(runtime))
+		enc.Uint32(boolToUint32(force))
+	})
+}
+
+// EncodeDisableUnitFiles encodes a request to systemd DisableUnitFiles method
+// which has the body signature "asb", i.e., the unit files such as "ssh.service",
+// and whether to disable them only until the next reboot.
+func (e *messageEncoder) EncodeDisableUnitFiles(conn io.Writer, files []string, runtime bool, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: "DisableUnitFiles", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+			{Signature: "g", S: "asb", Code: fieldSignature},
+		},
+	}
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.StringArray(files)
+		enc.Uint32(boolToUint32(runtime))
+	})
+}
+
+// boolToUint32 converts b to D-Bus BOOLEAN which is encoded as UINT32.
+func boolToUint32(b bool) uint32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// EncodeGetUnitProcesses encodes a request to systemd GetUnitProcesses method
+// which returns the processes of the unit.
+// The body signature is "s", i.e., the unit name such as "dbus.service".
+func (e *messageEncoder) EncodeGetUnitProcesses(conn io.Writer, unitName string, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: "GetUnitProcesses", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+			{Signature: "g", S: "s", Code: fieldSignature},
+		},
+	}
+	return e.encode(conn, &h, func(enc *encoder) {
+		enc.String(unitName)
+	})
+}
+
+// EncodeGetUnitByPIDFD encodes a request to systemd GetUnitByPIDFD method.
+// The body signature is "h", i.e., an index of the pidfd
+// in the array of file descriptors that accompany the message.
+// The pidfd itself is sent out-of-band, so conn must be a Unix domain socket.
+func (e *messageEncoder) EncodeGetUnitByPIDFD(conn io.Writer, pidfd int, msgSerial uint32) error {
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    msgSerial,
+		Fields: []headerField{
+			{Signature: "s", S: "GetUnitByPIDFD", Code: fieldMember},
+			{Signature: "s", S: "org.freedesktop.systemd1.Manager", Code: fieldInterface},
+			{Signature: "o", S: "/org/freedesktop/systemd1", Code: fieldPath},
+			{Signature: "s", S: "org.freedesktop.systemd1", Code: fieldDestination},
+			{Signature: "g", S: "h", Code: fieldSignature},
+			{Signature: "u", U: 1, Code: fieldUnixFDs},
+		},
+	}
+	e.fds = append(e.fds[:0], pidfd)
+	return e.encode(conn, &h, func(enc *encoder) {
+		// The pidfd is the first and only file descriptor.
+		enc.Uint32(0)
+	})
 }
@@ -2,9 +2,11 @@ package systemd
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"io"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -160,6 +162,47 @@ func TestDecodeMainPIDSignal(t *testing.T) {
 	}
 }
 
+func TestDecodeMainPIDSignalMaxBuffer(t *testing.T) {
+	conn := io.MultiReader(
+		bytes.NewReader(nameAcquiredSignal),
+		bytes.NewReader(mainPIDResponse),
+	)
+	msgDec := newMessageDecoder()
+	// The signal body is discarded in chunks of 16 bytes.
+	msgDec.Dec.SetMaxBufferSize(16)
+
+	pid, err := msgDec.DecodeMainPID(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want uint32 = 2375
+	if want != pid {
+		t.Errorf("expected pid %d got %d", want, pid)
+	}
+}
+
+func TestDecodeMainPIDReplySerial(t *testing.T) {
+	conn := io.MultiReader(
+		bytes.NewReader(nameAcquiredSignal),
+		// The reply to an earlier call which had been interrupted.
+		bytes.NewReader(encodeFakeReply(5, 2, ":1.100", fakeUint32Property(1))),
+		bytes.NewReader(encodeFakeReply(6, 3, ":1.100", fakeUint32Property(2375))),
+	)
+	msgDec := newMessageDecoder()
+	msgDec.ReplySerial = 3
+
+	pid, err := msgDec.DecodeMainPID(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want uint32 = 2375
+	if want != pid {
+		t.Errorf("expected pid %d got %d", want, pid)
+	}
+}
+
 func TestDecodeMainPIDError(t *testing.T) {
 	tt := map[string]struct {
 		in     []byte
@@ -173,6 +216,10 @@ func TestDecodeMainPIDError(t *testing.T) {
 			in:     mainPIDInvalidArgResponse,
 			errMsg: "Unit name blah is neither a valid invocation ID nor unit name.",
 		},
+		"signature mismatch": {
+			in:     memoryAvailableResponse,
+			errMsg: "expected pid variant signature u, got t",
+		},
 	}
 
 	msgDec := newMessageDecoder()
@@ -198,6 +245,99 @@ func TestDecodeMainPIDError(t *testing.T) {
 	}
 }
 
+func TestDecodeMainPIDBodyOverrun(t *testing.T) {
+	// The reply declares a body which is too short for the pid variant.
+	in := append([]byte{}, mainPIDResponse...)
+	binary.LittleEndian.PutUint32(in[4:], 4)
+
+	msgDec := newMessageDecoder()
+	_, err := msgDec.DecodeMainPID(bytes.NewReader(in))
+	if !errors.Is(err, ErrBodyOverrun) {
+		t.Fatalf("expected ErrBodyOverrun got %v", err)
+	}
+	want := "decode pid: failed decoding UINT32 at offset 68: read exceeded the message body: 4/0 bytes"
+	if err.Error() != want {
+		t.Errorf("expected error %q got %q", want, err)
+	}
+}
+
+func TestDecodeMainPIDProto(t *testing.T) {
+	// The reply claims to be sent with protocol version 2.
+	in := append([]byte{}, mainPIDResponse...)
+	in[3] = 2
+
+	msgDec := newMessageDecoder()
+	// The protocol version isn't checked by default.
+	if _, err := msgDec.DecodeMainPID(bytes.NewReader(in)); err != nil {
+		t.Fatal(err)
+	}
+
+	msgDec.CheckProto = true
+	_, err := msgDec.DecodeMainPID(bytes.NewReader(in))
+	want := "unsupported D-Bus protocol version: 2"
+	if err == nil || want != err.Error() {
+		t.Fatalf("expected error %q got %q", want, err)
+	}
+
+	if _, err = msgDec.DecodeMainPID(bytes.NewReader(mainPIDResponse)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecodeMainPIDStrict(t *testing.T) {
+	// The copies of the reply with a corrupted prologue.
+	corrupt := func(i int, b ...byte) []byte {
+		in := append([]byte{}, mainPIDResponse...)
+		copy(in[i:], b)
+		return in
+	}
+	tt := map[string]struct {
+		in     []byte
+		errMsg string
+	}{
+		"proto": {
+			in:     corrupt(3, 2),
+			errMsg: "unsupported D-Bus protocol version: 2",
+		},
+		"zero serial": {
+			in:     corrupt(8, 0, 0, 0, 0),
+			errMsg: "message serial must not be zero",
+		},
+		"method call": {
+			in:     corrupt(1, msgTypeMethodCall),
+			errMsg: "unexpected message type: 1",
+		},
+		"trailing arg": {
+			in:     mainPIDTrailingArgResponse,
+			errMsg: "discard body: message body has 15 undecoded bytes",
+		},
+	}
+
+	msgDec := newMessageDecoder()
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			msgDec.SkipHeaderFields = true
+			msgDec.Strict = false
+			// The reply is decoded on a best-effort basis by default.
+			if _, err := msgDec.DecodeMainPID(bytes.NewReader(tc.in)); err != nil {
+				t.Fatal(err)
+			}
+
+			msgDec.SkipHeaderFields = false
+			msgDec.Strict = true
+			_, err := msgDec.DecodeMainPID(bytes.NewReader(tc.in))
+			if err == nil || tc.errMsg != err.Error() {
+				t.Fatalf("expected error %q got %q", tc.errMsg, err)
+			}
+		})
+	}
+}
+
+// mainPIDTrailingArgResponse is a reply to mainPIDRequest
+// that contains an unexpected string argument after the PID.
+var mainPIDTrailingArgResponse = []byte{108, 2, 1, 1, 23, 0, 0, 0, 228, 8, 0, 0, 45, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 2, 118, 115, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 1, 117, 0, 0, 71, 9, 0, 0, 10, 0, 0, 0, 117, 110, 101, 120, 112, 101, 99, 116, 101, 100, 0}
+
 // mainPIDRequest is a D-Bus message to request the main PID of "dbus.service".
 var mainPIDRequest = []byte{108, 1, 0, 1, 52, 0, 0, 0, 3, 0, 0, 0, 160, 0, 0, 0, 1, 1, 111, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 3, 1, 115, 0, 3, 0, 0, 0, 71, 101, 116, 0, 0, 0, 0, 0, 2, 1, 115, 0, 31, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 0, 8, 1, 103, 0, 2, 115, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 83, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 7, 0, 0, 0, 77, 97, 105, 110, 80, 73, 68, 0}
 
@@ -273,6 +413,38 @@ func TestDecodeListUnits(t *testing.T) {
 	}
 }
 
+func TestDecodeListUnitsMaxArrayElements(t *testing.T) {
+	tt := map[string]struct {
+		max     int
+		wantErr error
+	}{
+		"no limit":       {max: 0},
+		"exact":          {max: 156},
+		"exceeded limit": {max: 100, wantErr: ErrTooManyElements},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			msgDec := newMessageDecoder()
+			msgDec.Dec.SetMaxArrayElements(tc.max)
+
+			var units int
+			err := msgDec.DecodeListUnits(bytes.NewReader(listUnitsResponse), nil, func(u *Unit) {
+				units++
+			})
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error %v got %v", tc.wantErr, err)
+			}
+			if tc.wantErr == nil && units != 156 {
+				t.Errorf("expected 156 units got %d", units)
+			}
+			if tc.wantErr != nil && units != tc.max {
+				t.Errorf("expected %d units got %d", tc.max, units)
+			}
+		})
+	}
+}
+
 func BenchmarkDecodeListUnits(b *testing.B) {
 	conn := bytes.NewReader(listUnitsResponse)
 	msgDec := newMessageDecoder()
@@ -292,6 +464,153 @@ func BenchmarkDecodeListUnits(b *testing.B) {
 	}
 }
 
+func TestDecodeCountUnits(t *testing.T) {
+	isInactiveService := func(fieldIndex int, s []byte) bool {
+		switch fieldIndex {
+		case 0:
+			return bytes.HasSuffix(s, []byte(".service"))
+		case 3:
+			return string(s) == "inactive"
+		default:
+			return true
+		}
+	}
+
+	var wantInactive int
+	for _, u := range expectedServices {
+		if u.ActiveState == "inactive" {
+			wantInactive++
+		}
+	}
+
+	tt := map[string]struct {
+		p    Predicate
+		want int
+	}{
+		"all":              {p: nil, want: 156},
+		"services":         {p: IsService, want: len(expectedServices)},
+		"inactive service": {p: isInactiveService, want: wantInactive},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conn := bytes.NewReader(listUnitsResponse)
+			msgDec := newMessageDecoder()
+
+			got, err := msgDec.DecodeCountUnits(conn, tc.p)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.want != got {
+				t.Errorf("expected %d units got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func BenchmarkDecodeCountUnits(b *testing.B) {
+	conn := bytes.NewReader(listUnitsResponse)
+	msgDec := newMessageDecoder()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		conn.Seek(0, io.SeekStart)
+
+		_, err := msgDec.DecodeCountUnits(conn, IsService)
+		if err != nil {
+			b.Error(err)
+		}
+	}
+}
+
+func TestDecodeFindUnit(t *testing.T) {
+	last := expectedServices[len(expectedServices)-1]
+	isLastService := func(fieldIndex int, s []byte) bool {
+		return fieldIndex != 0 || string(s) == last.Name
+	}
+	isNothing := func(fieldIndex int, s []byte) bool {
+		return fieldIndex != 0
+	}
+
+	tt := map[string]struct {
+		p     Predicate
+		want  Unit
+		found bool
+	}{
+		"first service": {p: IsService, want: expectedServices[0], found: true},
+		"last service":  {p: isLastService, want: last, found: true},
+		"not found":     {p: isNothing},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			// The reply following ListUnits must be decoded
+			// after the remaining units were discarded.
+			// Note, the captured ListUnits reply is followed by zero padding
+			// which is cut off.
+			conn := io.MultiReader(
+				bytes.NewReader(listUnitsResponse[:35794]),
+				bytes.NewReader(mainPIDResponse),
+			)
+			msgDec := newMessageDecoder()
+
+			got, found, err := msgDec.DecodeFindUnit(conn, tc.p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.found != found {
+				t.Errorf("expected found %t got %t", tc.found, found)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error(diff)
+			}
+
+			pid, err := msgDec.DecodeMainPID(conn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pid != 2375 {
+				t.Errorf("expected pid 2375 got %d", pid)
+			}
+		})
+	}
+}
+
+func TestPathHasPrefix(t *testing.T) {
+	tt := map[string]struct {
+		prefix string
+		want   []string
+	}{
+		"dbus": {
+			prefix: "/org/freedesktop/systemd1/unit/dbus_2e",
+			want:   []string{"dbus.socket", "dbus.service"},
+		},
+		"no match": {
+			prefix: "/org/freedesktop/systemd1/job/",
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conn := bytes.NewReader(listUnitsResponse)
+			msgDec := newMessageDecoder()
+
+			var got []string
+			err := msgDec.DecodeListUnits(conn, PathHasPrefix(tc.prefix), func(u *Unit) {
+				got = append(got, u.Name)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
 func TestDecodeListUnitsSignal(t *testing.T) {
 	conn := io.MultiReader(
 		bytes.NewReader(nameAcquiredSignal),
@@ -342,6 +661,70 @@ func TestDecodeListUnitsError(t *testing.T) {
 	}
 }
 
+func TestDecodeListUnitsTruncated(t *testing.T) {
+	// The listServicesResponse body starts at offset 80
+	// with the array length 330 bytes, and the body length is 338 bytes.
+	const (
+		bodyLenOffset = 4
+		arrLenOffset  = 80
+	)
+	tt := map[string]struct {
+		in     func() []byte
+		errMsg string
+	}{
+		"array exceeds body": {
+			in: func() []byte {
+				in := append([]byte{}, listServicesResponse...)
+				binary.LittleEndian.PutUint32(in[arrLenOffset:], 338)
+				return in
+			},
+			errMsg: "message body is truncated: array exceeded the message body: 338/330 bytes",
+		},
+		"array cuts last unit": {
+			in: func() []byte {
+				in := append([]byte{}, listServicesResponse...)
+				binary.LittleEndian.PutUint32(in[arrLenOffset:], 300)
+				return in
+			},
+			errMsg: "message body is truncated: last array element ended at offset 418 instead of 388",
+		},
+		"body cuts last unit": {
+			in: func() []byte {
+				in := append([]byte{}, listServicesResponse[:380]...)
+				binary.LittleEndian.PutUint32(in[bodyLenOffset:], 300)
+				binary.LittleEndian.PutUint32(in[arrLenOffset:], 292)
+				return in
+			},
+			errMsg: "message body: message body is truncated: failed decoding STRING at offset 348: read exceeded the message body: 45/28 bytes",
+		},
+		"connection cuts last unit": {
+			in: func() []byte {
+				return append([]byte{}, listServicesResponse[:380]...)
+			},
+			errMsg: "message body: message body is truncated: failed decoding STRING at offset 348: EOF",
+		},
+	}
+
+	msgDec := newMessageDecoder()
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			err := msgDec.DecodeListUnits(bytes.NewReader(tc.in()), nil, func(*Unit) {})
+			if !errors.Is(err, ErrTruncatedBody) {
+				t.Fatalf("expected ErrTruncatedBody got %v", err)
+			}
+			if tc.errMsg != err.Error() {
+				t.Errorf("expected error %q got %q", tc.errMsg, err)
+			}
+
+			_, err = msgDec.DecodeCountUnits(bytes.NewReader(tc.in()), nil)
+			if !errors.Is(err, ErrTruncatedBody) {
+				t.Errorf("count: expected ErrTruncatedBody got %v", err)
+			}
+		})
+	}
+}
+
 // The expected service units decoded from listUnitsResponse.
 var expectedServices = []Unit{
 	{
@@ -416,3 +799,1651 @@ var listUnitsAccessDeniedResponse = []byte{108, 3, 1, 1, 153, 1, 0, 0, 3, 0, 0,
 // listUnitsResponse is D-Bus message (35867 bytes)
 // that contains 157 Unit structs.
 var listUnitsResponse = []byte{108, 2, 1, 1, 130, 139, 0, 0, 222, 6, 0, 0, 61, 0, 0, 0, 5, 1, 117, 0, 2, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 48, 56, 0, 0, 8, 1, 103, 0, 13, 97, 40, 115, 115, 115, 115, 115, 115, 111, 117, 115, 111, 41, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 122, 139, 0, 0, 0, 0, 0, 0, 65, 0, 0, 0, 100, 101, 118, 45, 100, 105, 115, 107, 45, 98, 121, 92, 120, 50, 100, 112, 97, 116, 104, 45, 112, 99, 105, 92, 120, 50, 100, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 92, 120, 50, 100, 115, 99, 115, 105, 92, 120, 50, 100, 48, 58, 48, 58, 48, 58, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 8, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 78, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 48, 45, 50, 58, 48, 58, 48, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 97, 46, 100, 101, 118, 105, 99, 101, 0, 0, 124, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 100, 105, 115, 107, 95, 50, 100, 98, 121, 95, 53, 99, 120, 50, 100, 112, 97, 116, 104, 95, 50, 100, 112, 99, 105, 95, 53, 99, 120, 50, 100, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 51, 97, 49, 52, 95, 50, 101, 48, 95, 53, 99, 120, 50, 100, 115, 99, 115, 105, 95, 53, 99, 120, 50, 100, 48, 95, 51, 97, 48, 95, 51, 97, 48, 95, 51, 97, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 25, 0, 0, 0, 112, 107, 45, 100, 101, 98, 99, 111, 110, 102, 45, 104, 101, 108, 112, 101, 114, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 29, 0, 0, 0, 100, 101, 98, 99, 111, 110, 102, 32, 99, 111, 109, 109, 117, 110, 105, 99, 97, 116, 105, 111, 110, 32, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 62, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 112, 107, 95, 50, 100, 100, 101, 98, 99, 111, 110, 102, 95, 50, 100, 104, 101, 108, 112, 101, 114, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 53, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 50, 53, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 50, 53, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 45, 0, 0, 0, 100, 101, 118, 45, 100, 105, 115, 107, 45, 98, 121, 92, 120, 50, 100, 112, 97, 114, 116, 117, 117, 105, 100, 45, 97, 49, 100, 49, 53, 53, 53, 52, 92, 120, 50, 100, 48, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 24, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 32, 99, 108, 111, 117, 100, 105, 109, 103, 45, 114, 111, 111, 116, 102, 115, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 83, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 48, 45, 50, 58, 48, 58, 48, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 97, 45, 115, 100, 97, 49, 46, 100, 101, 118, 105, 99, 101, 0, 88, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 100, 105, 115, 107, 95, 50, 100, 98, 121, 95, 53, 99, 120, 50, 100, 112, 97, 114, 116, 117, 117, 105, 100, 95, 50, 100, 97, 49, 100, 49, 53, 53, 53, 52, 95, 53, 99, 120, 50, 100, 48, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 52, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 52, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 9, 0, 0, 0, 110, 111, 116, 45, 102, 111, 117, 110, 100, 0, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 62, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 98, 108, 111, 99, 107, 100, 101, 118, 95, 52, 48, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 52, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 108, 111, 111, 112, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 108, 111, 111, 112, 56, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 56, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 50, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 50, 51, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 50, 51, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 40, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 116, 116, 121, 45, 116, 116, 121, 112, 114, 105, 110, 116, 107, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 34, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 118, 105, 114, 116, 117, 97, 108, 47, 116, 116, 121, 47, 116, 116, 121, 112, 114, 105, 110, 116, 107, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 81, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 118, 105, 114, 116, 117, 97, 108, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 112, 114, 105, 110, 116, 107, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 20, 0, 0, 0, 103, 112, 103, 45, 97, 103, 101, 110, 116, 45, 115, 115, 104, 46, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 47, 0, 0, 0, 71, 110, 117, 80, 71, 32, 99, 114, 121, 112, 116, 111, 103, 114, 97, 112, 104, 105, 99, 32, 97, 103, 101, 110, 116, 32, 40, 115, 115, 104, 45, 97, 103, 101, 110, 116, 32, 101, 109, 117, 108, 97, 116, 105, 111, 110, 41, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 9, 0, 0, 0, 108, 105, 115, 116, 101, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 57, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 103, 112, 103, 95, 50, 100, 97, 103, 101, 110, 116, 95, 50, 100, 115, 115, 104, 95, 50, 101, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 54, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 50, 54, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 50, 54, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 49, 55, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 49, 55, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 55, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 49, 55, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 29, 0, 0, 0, 112, 114, 111, 99, 45, 115, 121, 115, 45, 102, 115, 45, 98, 105, 110, 102, 109, 116, 95, 109, 105, 115, 99, 46, 109, 111, 117, 110, 116, 0, 0, 0, 24, 0, 0, 0, 47, 112, 114, 111, 99, 47, 115, 121, 115, 47, 102, 115, 47, 98, 105, 110, 102, 109, 116, 95, 109, 105, 115, 99, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 70, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 112, 114, 111, 99, 95, 50, 100, 115, 121, 115, 95, 50, 100, 102, 115, 95, 50, 100, 98, 105, 110, 102, 109, 116, 95, 53, 102, 109, 105, 115, 99, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 15, 0, 0, 0, 100, 105, 114, 109, 110, 103, 114, 46, 115, 101, 114, 118, 105, 99, 101, 0, 43, 0, 0, 0, 71, 110, 117, 80, 71, 32, 110, 101, 116, 119, 111, 114, 107, 32, 99, 101, 114, 116, 105, 102, 105, 99, 97, 116, 101, 32, 109, 97, 110, 97, 103, 101, 109, 101, 110, 116, 32, 100, 97, 101, 109, 111, 110, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 48, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 105, 114, 109, 110, 103, 114, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 50, 55, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 50, 55, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 55, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 50, 55, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 52, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 50, 52, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 50, 52, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 98, 97, 115, 105, 99, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 12, 0, 0, 0, 66, 97, 115, 105, 99, 32, 83, 121, 115, 116, 101, 109, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 98, 97, 115, 105, 99, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 49, 57, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 49, 57, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 57, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 49, 57, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 49, 56, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 49, 56, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 32, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 118, 105, 114, 116, 117, 97, 108, 47, 98, 108, 111, 99, 107, 47, 108, 111, 111, 112, 48, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 79, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 118, 105, 114, 116, 117, 97, 108, 95, 50, 100, 98, 108, 111, 99, 107, 95, 50, 100, 108, 111, 111, 112, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 16, 0, 0, 0, 103, 112, 103, 45, 97, 103, 101, 110, 116, 46, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 46, 0, 0, 0, 71, 110, 117, 80, 71, 32, 99, 114, 121, 112, 116, 111, 103, 114, 97, 112, 104, 105, 99, 32, 97, 103, 101, 110, 116, 32, 97, 110, 100, 32, 112, 97, 115, 115, 112, 104, 114, 97, 115, 101, 32, 99, 97, 99, 104, 101, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 9, 0, 0, 0, 108, 105, 115, 116, 101, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 103, 112, 103, 95, 50, 100, 97, 103, 101, 110, 116, 95, 50, 101, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 23, 0, 0, 0, 115, 121, 115, 45, 107, 101, 114, 110, 101, 108, 45, 99, 111, 110, 102, 105, 103, 46, 109, 111, 117, 110, 116, 0, 18, 0, 0, 0, 47, 115, 121, 115, 47, 107, 101, 114, 110, 101, 108, 47, 99, 111, 110, 102, 105, 103, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 60, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 107, 101, 114, 110, 101, 108, 95, 50, 100, 99, 111, 110, 102, 105, 103, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 22, 0, 0, 0, 115, 110, 97, 112, 45, 99, 111, 114, 101, 50, 48, 45, 49, 56, 50, 50, 46, 109, 111, 117, 110, 116, 0, 0, 17, 0, 0, 0, 47, 115, 110, 97, 112, 47, 99, 111, 114, 101, 50, 48, 47, 49, 56, 50, 50, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 59, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 110, 97, 112, 95, 50, 100, 99, 111, 114, 101, 50, 48, 95, 50, 100, 49, 56, 50, 50, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 56, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 56, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 9, 0, 0, 0, 110, 111, 116, 45, 102, 111, 117, 110, 100, 0, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 62, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 98, 108, 111, 99, 107, 100, 101, 118, 95, 52, 48, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 56, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 50, 46, 100, 101, 118, 105, 99, 101, 0, 0, 32, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 118, 105, 114, 116, 117, 97, 108, 47, 98, 108, 111, 99, 107, 47, 108, 111, 111, 112, 50, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 79, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 118, 105, 114, 116, 117, 97, 108, 95, 50, 100, 98, 108, 111, 99, 107, 95, 50, 100, 108, 111, 111, 112, 50, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 20, 0, 0, 0, 115, 110, 97, 112, 45, 108, 120, 100, 45, 50, 52, 51, 50, 50, 46, 109, 111, 117, 110, 116, 0, 0, 0, 0, 15, 0, 0, 0, 47, 115, 110, 97, 112, 47, 108, 120, 100, 47, 50, 52, 51, 50, 50, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 57, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 110, 97, 112, 95, 50, 100, 108, 120, 100, 95, 50, 100, 50, 52, 51, 50, 50, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 24, 0, 0, 0, 103, 112, 103, 45, 97, 103, 101, 110, 116, 45, 98, 114, 111, 119, 115, 101, 114, 46, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 72, 0, 0, 0, 71, 110, 117, 80, 71, 32, 99, 114, 121, 112, 116, 111, 103, 114, 97, 112, 104, 105, 99, 32, 97, 103, 101, 110, 116, 32, 97, 110, 100, 32, 112, 97, 115, 115, 112, 104, 114, 97, 115, 101, 32, 99, 97, 99, 104, 101, 32, 40, 97, 99, 99, 101, 115, 115, 32, 102, 111, 114, 32, 119, 101, 98, 32, 98, 114, 111, 119, 115, 101, 114, 115, 41, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 9, 0, 0, 0, 108, 105, 115, 116, 101, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 61, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 103, 112, 103, 95, 50, 100, 97, 103, 101, 110, 116, 95, 50, 100, 98, 114, 111, 119, 115, 101, 114, 95, 50, 101, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 22, 0, 0, 0, 115, 110, 97, 112, 45, 115, 110, 97, 112, 100, 45, 49, 56, 51, 53, 55, 46, 109, 111, 117, 110, 116, 0, 0, 17, 0, 0, 0, 47, 115, 110, 97, 112, 47, 115, 110, 97, 112, 100, 47, 49, 56, 51, 53, 55, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 59, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 110, 97, 112, 95, 50, 100, 115, 110, 97, 112, 100, 95, 50, 100, 49, 56, 51, 53, 55, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 24, 0, 0, 0, 112, 107, 45, 100, 101, 98, 99, 111, 110, 102, 45, 104, 101, 108, 112, 101, 114, 46, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 28, 0, 0, 0, 100, 101, 98, 99, 111, 110, 102, 32, 99, 111, 109, 109, 117, 110, 105, 99, 97, 116, 105, 111, 110, 32, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 9, 0, 0, 0, 108, 105, 115, 116, 101, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 61, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 112, 107, 95, 50, 100, 100, 101, 98, 99, 111, 110, 102, 95, 50, 100, 104, 101, 108, 112, 101, 114, 95, 50, 101, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 32, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 118, 105, 114, 116, 117, 97, 108, 47, 98, 108, 111, 99, 107, 47, 108, 111, 111, 112, 49, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 79, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 118, 105, 114, 116, 117, 97, 108, 95, 50, 100, 98, 108, 111, 99, 107, 95, 50, 100, 108, 111, 111, 112, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 39, 0, 0, 0, 115, 121, 115, 45, 115, 117, 98, 115, 121, 115, 116, 101, 109, 45, 110, 101, 116, 45, 100, 101, 118, 105, 99, 101, 115, 45, 101, 110, 112, 48, 115, 56, 46, 100, 101, 118, 105, 99, 101, 0, 65, 0, 0, 0, 56, 50, 53, 52, 48, 69, 77, 32, 71, 105, 103, 97, 98, 105, 116, 32, 69, 116, 104, 101, 114, 110, 101, 116, 32, 67, 111, 110, 116, 114, 111, 108, 108, 101, 114, 32, 40, 80, 82, 79, 47, 49, 48, 48, 48, 32, 77, 84, 32, 68, 101, 115, 107, 116, 111, 112, 32, 65, 100, 97, 112, 116, 101, 114, 41, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 80, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 115, 117, 98, 115, 121, 115, 116, 101, 109, 95, 50, 100, 110, 101, 116, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 101, 110, 112, 48, 115, 56, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 51, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 51, 49, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 51, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 51, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 53, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 42, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 53, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 91, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 53, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 78, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 49, 45, 50, 58, 48, 58, 49, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 98, 46, 100, 101, 118, 105, 99, 101, 0, 0, 15, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 32, 99, 105, 100, 97, 116, 97, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 145, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 99, 105, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 50, 100, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 51, 97, 49, 52, 95, 50, 101, 48, 95, 50, 100, 104, 111, 115, 116, 50, 95, 50, 100, 116, 97, 114, 103, 101, 116, 50, 95, 51, 97, 48, 95, 51, 97, 49, 95, 50, 100, 50, 95, 51, 97, 48, 95, 51, 97, 49, 95, 51, 97, 48, 95, 50, 100, 98, 108, 111, 99, 107, 95, 50, 100, 115, 100, 98, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 34, 0, 0, 0, 100, 101, 118, 45, 100, 105, 115, 107, 45, 98, 121, 92, 120, 50, 100, 108, 97, 98, 101, 108, 45, 99, 105, 100, 97, 116, 97, 46, 100, 101, 118, 105, 99, 101, 0, 0, 15, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 32, 99, 105, 100, 97, 116, 97, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 78, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 49, 45, 50, 58, 48, 58, 49, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 98, 46, 100, 101, 118, 105, 99, 101, 0, 0, 75, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 100, 105, 115, 107, 95, 50, 100, 98, 121, 95, 53, 99, 120, 50, 100, 108, 97, 98, 101, 108, 95, 50, 100, 99, 105, 100, 97, 116, 97, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 22, 0, 0, 0, 115, 121, 115, 45, 107, 101, 114, 110, 101, 108, 45, 100, 101, 98, 117, 103, 46, 109, 111, 117, 110, 116, 0, 0, 17, 0, 0, 0, 47, 115, 121, 115, 47, 107, 101, 114, 110, 101, 108, 47, 100, 101, 98, 117, 103, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 59, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 107, 101, 114, 110, 101, 108, 95, 50, 100, 100, 101, 98, 117, 103, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 48, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 48, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 9, 0, 0, 0, 110, 111, 116, 45, 102, 111, 117, 110, 100, 0, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 62, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 98, 108, 111, 99, 107, 100, 101, 118, 95, 52, 48, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 48, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 108, 111, 111, 112, 52, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 108, 111, 111, 112, 52, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 52, 46, 100, 101, 118, 105, 99, 101, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 52, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 15, 0, 0, 0, 115, 104, 117, 116, 100, 111, 119, 110, 46, 116, 97, 114, 103, 101, 116, 0, 8, 0, 0, 0, 83, 104, 117, 116, 100, 111, 119, 110, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 48, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 104, 117, 116, 100, 111, 119, 110, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 49, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 49, 49, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 49, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 42, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 50, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 91, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 50, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 50, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 50, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 50, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 108, 111, 111, 112, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 108, 111, 111, 112, 49, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 26, 0, 0, 0, 114, 117, 110, 45, 115, 110, 97, 112, 100, 45, 110, 115, 45, 108, 120, 100, 46, 109, 110, 116, 46, 109, 111, 117, 110, 116, 0, 0, 21, 0, 0, 0, 47, 114, 117, 110, 47, 115, 110, 97, 112, 100, 47, 110, 115, 47, 108, 120, 100, 46, 109, 110, 116, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 67, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 114, 117, 110, 95, 50, 100, 115, 110, 97, 112, 100, 95, 50, 100, 110, 115, 95, 50, 100, 108, 120, 100, 95, 50, 101, 109, 110, 116, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 116, 105, 109, 101, 114, 115, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 6, 0, 0, 0, 84, 105, 109, 101, 114, 115, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 46, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 116, 105, 109, 101, 114, 115, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 57, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 50, 57, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 50, 57, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 54, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 49, 54, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 49, 54, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 26, 0, 0, 0, 115, 121, 115, 45, 109, 111, 100, 117, 108, 101, 45, 99, 111, 110, 102, 105, 103, 102, 115, 46, 100, 101, 118, 105, 99, 101, 0, 0, 20, 0, 0, 0, 47, 115, 121, 115, 47, 109, 111, 100, 117, 108, 101, 47, 99, 111, 110, 102, 105, 103, 102, 115, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 63, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 109, 111, 100, 117, 108, 101, 95, 50, 100, 99, 111, 110, 102, 105, 103, 102, 115, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 50, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 50, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 9, 0, 0, 0, 110, 111, 116, 45, 102, 111, 117, 110, 100, 0, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 62, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 98, 108, 111, 99, 107, 100, 101, 118, 95, 52, 48, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 50, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 55, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 42, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 55, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 91, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 55, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 14, 0, 0, 0, 115, 111, 99, 107, 101, 116, 115, 46, 116, 97, 114, 103, 101, 116, 0, 0, 7, 0, 0, 0, 83, 111, 99, 107, 101, 116, 115, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 47, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 111, 99, 107, 101, 116, 115, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 49, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 49, 51, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 49, 51, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 42, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 51, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 91, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 51, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 53, 46, 100, 101, 118, 105, 99, 101, 0, 0, 32, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 118, 105, 114, 116, 117, 97, 108, 47, 98, 108, 111, 99, 107, 47, 108, 111, 111, 112, 53, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 79, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 118, 105, 114, 116, 117, 97, 108, 95, 50, 100, 98, 108, 111, 99, 107, 95, 50, 100, 108, 111, 111, 112, 53, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 42, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 49, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 91, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 14, 0, 0, 0, 100, 105, 114, 109, 110, 103, 114, 46, 115, 111, 99, 107, 101, 116, 0, 0, 43, 0, 0, 0, 71, 110, 117, 80, 71, 32, 110, 101, 116, 119, 111, 114, 107, 32, 99, 101, 114, 116, 105, 102, 105, 99, 97, 116, 101, 32, 109, 97, 110, 97, 103, 101, 109, 101, 110, 116, 32, 100, 97, 101, 109, 111, 110, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 9, 0, 0, 0, 108, 105, 115, 116, 101, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 47, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 105, 114, 109, 110, 103, 114, 95, 50, 101, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 49, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 49, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 9, 0, 0, 0, 110, 111, 116, 45, 102, 111, 117, 110, 100, 0, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 62, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 98, 108, 111, 99, 107, 100, 101, 118, 95, 52, 48, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 49, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 52, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 52, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 52, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 52, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 55, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 49, 55, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 49, 55, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 53, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 48, 56, 46, 48, 45, 110, 101, 116, 45, 101, 110, 112, 48, 115, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 65, 0, 0, 0, 56, 50, 53, 52, 48, 69, 77, 32, 71, 105, 103, 97, 98, 105, 116, 32, 69, 116, 104, 101, 114, 110, 101, 116, 32, 67, 111, 110, 116, 114, 111, 108, 108, 101, 114, 32, 40, 80, 82, 79, 47, 49, 48, 48, 48, 32, 77, 84, 32, 68, 101, 115, 107, 116, 111, 112, 32, 65, 100, 97, 112, 116, 101, 114, 41, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 104, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 99, 105, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 50, 100, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 51, 97, 48, 56, 95, 50, 101, 48, 95, 50, 100, 110, 101, 116, 95, 50, 100, 101, 110, 112, 48, 115, 56, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 50, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 49, 50, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 49, 50, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 20, 0, 0, 0, 115, 110, 97, 112, 45, 108, 120, 100, 45, 50, 51, 53, 52, 49, 46, 109, 111, 117, 110, 116, 0, 0, 0, 0, 15, 0, 0, 0, 47, 115, 110, 97, 112, 47, 108, 120, 100, 47, 50, 51, 53, 52, 49, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 57, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 110, 97, 112, 95, 50, 100, 108, 120, 100, 95, 50, 100, 50, 51, 53, 52, 49, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 49, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 49, 48, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 49, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 22, 0, 0, 0, 103, 112, 103, 45, 97, 103, 101, 110, 116, 45, 101, 120, 116, 114, 97, 46, 115, 111, 99, 107, 101, 116, 0, 0, 59, 0, 0, 0, 71, 110, 117, 80, 71, 32, 99, 114, 121, 112, 116, 111, 103, 114, 97, 112, 104, 105, 99, 32, 97, 103, 101, 110, 116, 32, 97, 110, 100, 32, 112, 97, 115, 115, 112, 104, 114, 97, 115, 101, 32, 99, 97, 99, 104, 101, 32, 40, 114, 101, 115, 116, 114, 105, 99, 116, 101, 100, 41, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 9, 0, 0, 0, 108, 105, 115, 116, 101, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 59, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 103, 112, 103, 95, 50, 100, 97, 103, 101, 110, 116, 95, 50, 100, 101, 120, 116, 114, 97, 95, 50, 101, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 108, 111, 111, 112, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 108, 111, 111, 112, 51, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 51, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 51, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 51, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 9, 0, 0, 0, 110, 111, 116, 45, 102, 111, 117, 110, 100, 0, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 62, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 98, 108, 111, 99, 107, 100, 101, 118, 95, 52, 48, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 51, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 50, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 50, 49, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 50, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 65, 0, 0, 0, 100, 101, 118, 45, 100, 105, 115, 107, 45, 98, 121, 92, 120, 50, 100, 112, 97, 116, 104, 45, 112, 99, 105, 92, 120, 50, 100, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 92, 120, 50, 100, 115, 99, 115, 105, 92, 120, 50, 100, 48, 58, 48, 58, 49, 58, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 15, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 32, 99, 105, 100, 97, 116, 97, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 78, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 49, 45, 50, 58, 48, 58, 49, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 98, 46, 100, 101, 118, 105, 99, 101, 0, 0, 124, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 100, 105, 115, 107, 95, 50, 100, 98, 121, 95, 53, 99, 120, 50, 100, 112, 97, 116, 104, 95, 50, 100, 112, 99, 105, 95, 53, 99, 120, 50, 100, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 51, 97, 49, 52, 95, 50, 101, 48, 95, 53, 99, 120, 50, 100, 115, 99, 115, 105, 95, 53, 99, 120, 50, 100, 48, 95, 51, 97, 48, 95, 51, 97, 49, 95, 51, 97, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 49, 49, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 49, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 50, 53, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 50, 53, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 53, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 50, 53, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 57, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 57, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 57, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 57, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 57, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 49, 57, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 49, 57, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 67, 0, 0, 0, 100, 101, 118, 45, 100, 105, 115, 107, 45, 98, 121, 92, 120, 50, 100, 117, 117, 105, 100, 45, 50, 48, 50, 50, 92, 120, 50, 100, 49, 49, 92, 120, 50, 100, 50, 52, 92, 120, 50, 100, 48, 50, 92, 120, 50, 100, 50, 52, 92, 120, 50, 100, 48, 56, 92, 120, 50, 100, 48, 48, 46, 100, 101, 118, 105, 99, 101, 0, 15, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 32, 99, 105, 100, 97, 116, 97, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 78, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 49, 45, 50, 58, 48, 58, 49, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 98, 46, 100, 101, 118, 105, 99, 101, 0, 0, 120, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 100, 105, 115, 107, 95, 50, 100, 98, 121, 95, 53, 99, 120, 50, 100, 117, 117, 105, 100, 95, 50, 100, 50, 48, 50, 50, 95, 53, 99, 120, 50, 100, 49, 49, 95, 53, 99, 120, 50, 100, 50, 52, 95, 53, 99, 120, 50, 100, 48, 50, 95, 53, 99, 120, 50, 100, 50, 52, 95, 53, 99, 120, 50, 100, 48, 56, 95, 53, 99, 120, 50, 100, 48, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 49, 52, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 49, 52, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 52, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 49, 52, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 55, 46, 100, 101, 118, 105, 99, 101, 0, 0, 32, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 118, 105, 114, 116, 117, 97, 108, 47, 98, 108, 111, 99, 107, 47, 108, 111, 111, 112, 55, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 79, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 118, 105, 114, 116, 117, 97, 108, 95, 50, 100, 98, 108, 111, 99, 107, 95, 50, 100, 108, 111, 111, 112, 55, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 9, 0, 0, 0, 97, 112, 112, 46, 115, 108, 105, 99, 101, 0, 0, 0, 22, 0, 0, 0, 85, 115, 101, 114, 32, 65, 112, 112, 108, 105, 99, 97, 116, 105, 111, 110, 32, 83, 108, 105, 99, 101, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 97, 112, 112, 95, 50, 101, 115, 108, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 100, 98, 117, 115, 46, 115, 111, 99, 107, 101, 116, 0, 29, 0, 0, 0, 68, 45, 66, 117, 115, 32, 85, 115, 101, 114, 32, 77, 101, 115, 115, 97, 103, 101, 32, 66, 117, 115, 32, 83, 111, 99, 107, 101, 116, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 114, 117, 110, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 44, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 54, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 54, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 54, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 54, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 46, 0, 0, 0, 100, 101, 118, 45, 100, 105, 115, 107, 45, 98, 121, 92, 120, 50, 100, 108, 97, 98, 101, 108, 45, 99, 108, 111, 117, 100, 105, 109, 103, 92, 120, 50, 100, 114, 111, 111, 116, 102, 115, 46, 100, 101, 118, 105, 99, 101, 0, 0, 24, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 32, 99, 108, 111, 117, 100, 105, 109, 103, 45, 114, 111, 111, 116, 102, 115, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 83, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 48, 45, 50, 58, 48, 58, 48, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 97, 45, 115, 100, 97, 49, 46, 100, 101, 118, 105, 99, 101, 0, 89, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 100, 105, 115, 107, 95, 50, 100, 98, 121, 95, 53, 99, 120, 50, 100, 108, 97, 98, 101, 108, 95, 50, 100, 99, 108, 111, 117, 100, 105, 109, 103, 95, 53, 99, 120, 50, 100, 114, 111, 111, 116, 102, 115, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 53, 0, 0, 0, 115, 110, 97, 112, 46, 103, 111, 46, 103, 111, 46, 48, 97, 98, 48, 100, 57, 57, 53, 45, 57, 97, 55, 102, 45, 52, 48, 99, 97, 45, 98, 50, 52, 57, 45, 99, 97, 54, 51, 53, 56, 102, 101, 54, 100, 57, 100, 46, 115, 99, 111, 112, 101, 0, 0, 0, 53, 0, 0, 0, 115, 110, 97, 112, 46, 103, 111, 46, 103, 111, 46, 48, 97, 98, 48, 100, 57, 57, 53, 45, 57, 97, 55, 102, 45, 52, 48, 99, 97, 45, 98, 50, 52, 57, 45, 99, 97, 54, 51, 53, 56, 102, 101, 54, 100, 57, 100, 46, 115, 99, 111, 112, 101, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 114, 117, 110, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 100, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 110, 97, 112, 95, 50, 101, 103, 111, 95, 50, 101, 103, 111, 95, 50, 101, 48, 97, 98, 48, 100, 57, 57, 53, 95, 50, 100, 57, 97, 55, 102, 95, 50, 100, 52, 48, 99, 97, 95, 50, 100, 98, 50, 52, 57, 95, 50, 100, 99, 97, 54, 51, 53, 56, 102, 101, 54, 100, 57, 100, 95, 50, 101, 115, 99, 111, 112, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 51, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 51, 48, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 51, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 53, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 48, 51, 46, 48, 45, 110, 101, 116, 45, 101, 110, 112, 48, 115, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 65, 0, 0, 0, 56, 50, 53, 52, 48, 69, 77, 32, 71, 105, 103, 97, 98, 105, 116, 32, 69, 116, 104, 101, 114, 110, 101, 116, 32, 67, 111, 110, 116, 114, 111, 108, 108, 101, 114, 32, 40, 80, 82, 79, 47, 49, 48, 48, 48, 32, 77, 84, 32, 68, 101, 115, 107, 116, 111, 112, 32, 65, 100, 97, 112, 116, 101, 114, 41, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 104, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 99, 105, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 50, 100, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 51, 97, 48, 51, 95, 50, 101, 48, 95, 50, 100, 110, 101, 116, 95, 50, 100, 101, 110, 112, 48, 115, 51, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 24, 0, 0, 0, 115, 121, 115, 45, 107, 101, 114, 110, 101, 108, 45, 116, 114, 97, 99, 105, 110, 103, 46, 109, 111, 117, 110, 116, 0, 0, 0, 0, 19, 0, 0, 0, 47, 115, 121, 115, 47, 107, 101, 114, 110, 101, 108, 47, 116, 114, 97, 99, 105, 110, 103, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 61, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 107, 101, 114, 110, 101, 108, 95, 50, 100, 116, 114, 97, 99, 105, 110, 103, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 53, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 53, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 53, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 53, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 42, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 56, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 91, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 56, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 18, 0, 0, 0, 114, 117, 110, 45, 115, 110, 97, 112, 100, 45, 110, 115, 46, 109, 111, 117, 110, 116, 0, 0, 13, 0, 0, 0, 47, 114, 117, 110, 47, 115, 110, 97, 112, 100, 47, 110, 115, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 55, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 114, 117, 110, 95, 50, 100, 115, 110, 97, 112, 100, 95, 50, 100, 110, 115, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 32, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 118, 105, 114, 116, 117, 97, 108, 47, 98, 108, 111, 99, 107, 47, 108, 111, 111, 112, 51, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 79, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 118, 105, 114, 116, 117, 97, 108, 95, 50, 100, 98, 108, 111, 99, 107, 95, 50, 100, 108, 111, 111, 112, 51, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 19, 0, 0, 0, 114, 117, 110, 45, 117, 115, 101, 114, 45, 49, 48, 48, 48, 46, 109, 111, 117, 110, 116, 0, 14, 0, 0, 0, 47, 114, 117, 110, 47, 117, 115, 101, 114, 47, 49, 48, 48, 48, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 56, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 114, 117, 110, 95, 50, 100, 117, 115, 101, 114, 95, 50, 100, 49, 48, 48, 48, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 108, 111, 111, 112, 55, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 108, 111, 111, 112, 55, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 55, 46, 100, 101, 118, 105, 99, 101, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 55, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 15, 0, 0, 0, 100, 101, 118, 45, 115, 100, 97, 49, 46, 100, 101, 118, 105, 99, 101, 0, 24, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 32, 99, 108, 111, 117, 100, 105, 109, 103, 45, 114, 111, 111, 116, 102, 115, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 83, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 48, 45, 50, 58, 48, 58, 48, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 97, 45, 115, 100, 97, 49, 46, 100, 101, 118, 105, 99, 101, 0, 50, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 115, 100, 97, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 78, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 48, 45, 50, 58, 48, 58, 48, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 97, 46, 100, 101, 118, 105, 99, 101, 0, 0, 8, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 145, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 99, 105, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 50, 100, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 51, 97, 49, 52, 95, 50, 101, 48, 95, 50, 100, 104, 111, 115, 116, 50, 95, 50, 100, 116, 97, 114, 103, 101, 116, 50, 95, 51, 97, 48, 95, 51, 97, 48, 95, 50, 100, 50, 95, 51, 97, 48, 95, 51, 97, 48, 95, 51, 97, 48, 95, 50, 100, 98, 108, 111, 99, 107, 95, 50, 100, 115, 100, 97, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 19, 0, 0, 0, 100, 101, 118, 45, 104, 117, 103, 101, 112, 97, 103, 101, 115, 46, 109, 111, 117, 110, 116, 0, 14, 0, 0, 0, 47, 100, 101, 118, 47, 104, 117, 103, 101, 112, 97, 103, 101, 115, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 54, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 104, 117, 103, 101, 112, 97, 103, 101, 115, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 50, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 50, 50, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 50, 50, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 112, 97, 116, 104, 115, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 5, 0, 0, 0, 80, 97, 116, 104, 115, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 112, 97, 116, 104, 115, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 48, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 39, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 110, 112, 48, 45, 48, 48, 58, 48, 50, 45, 116, 116, 121, 45, 116, 116, 121, 83, 48, 46, 100, 101, 118, 105, 99, 101, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 50, 48, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 50, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 53, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 53, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 9, 0, 0, 0, 110, 111, 116, 45, 102, 111, 117, 110, 100, 0, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 62, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 98, 108, 111, 99, 107, 100, 101, 118, 95, 52, 48, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 53, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 45, 46, 115, 108, 105, 99, 101, 0, 10, 0, 0, 0, 82, 111, 111, 116, 32, 83, 108, 105, 99, 101, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 95, 50, 100, 95, 50, 101, 115, 108, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 50, 52, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 50, 52, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 52, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 50, 52, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 19, 0, 0, 0, 115, 110, 97, 112, 45, 103, 111, 45, 49, 48, 48, 48, 56, 46, 109, 111, 117, 110, 116, 0, 14, 0, 0, 0, 47, 115, 110, 97, 112, 47, 103, 111, 47, 49, 48, 48, 48, 56, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 56, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 110, 97, 112, 95, 50, 100, 103, 111, 95, 50, 100, 49, 48, 48, 48, 56, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 22, 0, 0, 0, 115, 121, 115, 45, 109, 111, 100, 117, 108, 101, 45, 102, 117, 115, 101, 46, 100, 101, 118, 105, 99, 101, 0, 0, 16, 0, 0, 0, 47, 115, 121, 115, 47, 109, 111, 100, 117, 108, 101, 47, 102, 117, 115, 101, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 59, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 109, 111, 100, 117, 108, 101, 95, 50, 100, 102, 117, 115, 101, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 32, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 118, 105, 114, 116, 117, 97, 108, 47, 98, 108, 111, 99, 107, 47, 108, 111, 111, 112, 56, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 79, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 118, 105, 114, 116, 117, 97, 108, 95, 50, 100, 98, 108, 111, 99, 107, 95, 50, 100, 108, 111, 111, 112, 56, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 108, 111, 111, 112, 50, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 108, 111, 111, 112, 50, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 50, 46, 100, 101, 118, 105, 99, 101, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 50, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 19, 0, 0, 0, 115, 110, 97, 112, 45, 103, 111, 45, 49, 48, 48, 51, 48, 46, 109, 111, 117, 110, 116, 0, 14, 0, 0, 0, 47, 115, 110, 97, 112, 47, 103, 111, 47, 49, 48, 48, 51, 48, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 56, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 110, 97, 112, 95, 50, 100, 103, 111, 95, 50, 100, 49, 48, 48, 51, 48, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 49, 48, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 49, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 26, 0, 0, 0, 115, 110, 97, 112, 100, 46, 115, 101, 115, 115, 105, 111, 110, 45, 97, 103, 101, 110, 116, 46, 115, 111, 99, 107, 101, 116, 0, 0, 44, 0, 0, 0, 82, 69, 83, 84, 32, 65, 80, 73, 32, 115, 111, 99, 107, 101, 116, 32, 102, 111, 114, 32, 115, 110, 97, 112, 100, 32, 117, 115, 101, 114, 32, 115, 101, 115, 115, 105, 111, 110, 32, 97, 103, 101, 110, 116, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 9, 0, 0, 0, 108, 105, 115, 116, 101, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 63, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 110, 97, 112, 100, 95, 50, 101, 115, 101, 115, 115, 105, 111, 110, 95, 50, 100, 97, 103, 101, 110, 116, 95, 50, 101, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 49, 53, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 49, 53, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 53, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 49, 53, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 22, 0, 0, 0, 68, 45, 66, 117, 115, 32, 85, 115, 101, 114, 32, 77, 101, 115, 115, 97, 103, 101, 32, 66, 117, 115, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 114, 117, 110, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 50, 49, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 50, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 108, 111, 111, 112, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 108, 111, 111, 112, 48, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 55, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 50, 55, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 50, 55, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 49, 50, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 49, 50, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 50, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 49, 50, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 52, 46, 100, 101, 118, 105, 99, 101, 0, 0, 32, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 118, 105, 114, 116, 117, 97, 108, 47, 98, 108, 111, 99, 107, 47, 108, 111, 111, 112, 52, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 79, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 118, 105, 114, 116, 117, 97, 108, 95, 50, 100, 98, 108, 111, 99, 107, 95, 50, 100, 108, 111, 111, 112, 52, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 22, 0, 0, 0, 115, 110, 97, 112, 45, 115, 110, 97, 112, 100, 45, 49, 55, 57, 53, 48, 46, 109, 111, 117, 110, 116, 0, 0, 17, 0, 0, 0, 47, 115, 110, 97, 112, 47, 115, 110, 97, 112, 100, 47, 49, 55, 57, 53, 48, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 59, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 110, 97, 112, 95, 50, 100, 115, 110, 97, 112, 100, 95, 50, 100, 49, 55, 57, 53, 48, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 114, 102, 107, 105, 108, 108, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 114, 102, 107, 105, 108, 108, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 109, 105, 115, 99, 45, 114, 102, 107, 105, 108, 108, 46, 100, 101, 118, 105, 99, 101, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 114, 102, 107, 105, 108, 108, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 51, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 51, 49, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 51, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 53, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 49, 53, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 49, 53, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 20, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 112, 114, 105, 110, 116, 107, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 14, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 112, 114, 105, 110, 116, 107, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 40, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 116, 116, 121, 45, 116, 116, 121, 112, 114, 105, 110, 116, 107, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 55, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 112, 114, 105, 110, 116, 107, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 74, 0, 0, 0, 100, 101, 118, 45, 100, 105, 115, 107, 45, 98, 121, 92, 120, 50, 100, 112, 97, 116, 104, 45, 112, 99, 105, 92, 120, 50, 100, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 92, 120, 50, 100, 115, 99, 115, 105, 92, 120, 50, 100, 48, 58, 48, 58, 48, 58, 48, 92, 120, 50, 100, 112, 97, 114, 116, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 24, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 32, 99, 108, 111, 117, 100, 105, 109, 103, 45, 114, 111, 111, 116, 102, 115, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 83, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 48, 45, 50, 58, 48, 58, 48, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 97, 45, 115, 100, 97, 49, 46, 100, 101, 118, 105, 99, 101, 0, 135, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 100, 105, 115, 107, 95, 50, 100, 98, 121, 95, 53, 99, 120, 50, 100, 112, 97, 116, 104, 95, 50, 100, 112, 99, 105, 95, 53, 99, 120, 50, 100, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 51, 97, 49, 52, 95, 50, 101, 48, 95, 53, 99, 120, 50, 100, 115, 99, 115, 105, 95, 53, 99, 120, 50, 100, 48, 95, 51, 97, 48, 95, 51, 97, 48, 95, 51, 97, 48, 95, 53, 99, 120, 50, 100, 112, 97, 114, 116, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 100, 101, 118, 45, 115, 100, 97, 46, 100, 101, 118, 105, 99, 101, 0, 0, 8, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 78, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 48, 45, 50, 58, 48, 58, 48, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 97, 46, 100, 101, 118, 105, 99, 101, 0, 0, 49, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 115, 100, 97, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 22, 0, 0, 0, 115, 110, 97, 112, 45, 99, 111, 114, 101, 50, 48, 45, 49, 55, 55, 56, 46, 109, 111, 117, 110, 116, 0, 0, 17, 0, 0, 0, 47, 115, 110, 97, 112, 47, 99, 111, 114, 101, 50, 48, 47, 49, 55, 55, 56, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 59, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 110, 97, 112, 95, 50, 100, 99, 111, 114, 101, 50, 48, 95, 50, 100, 49, 55, 55, 56, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 75, 0, 0, 0, 100, 101, 118, 45, 100, 105, 115, 107, 45, 98, 121, 92, 120, 50, 100, 117, 117, 105, 100, 45, 55, 100, 56, 49, 100, 100, 52, 55, 92, 120, 50, 100, 54, 100, 97, 54, 92, 120, 50, 100, 52, 48, 101, 52, 92, 120, 50, 100, 97, 100, 98, 99, 92, 120, 50, 100, 53, 52, 51, 52, 99, 99, 49, 101, 98, 97, 57, 101, 46, 100, 101, 118, 105, 99, 101, 0, 24, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 32, 99, 108, 111, 117, 100, 105, 109, 103, 45, 114, 111, 111, 116, 102, 115, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 83, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 48, 45, 50, 58, 48, 58, 48, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 97, 45, 115, 100, 97, 49, 46, 100, 101, 118, 105, 99, 101, 0, 124, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 100, 105, 115, 107, 95, 50, 100, 98, 121, 95, 53, 99, 120, 50, 100, 117, 117, 105, 100, 95, 50, 100, 55, 100, 56, 49, 100, 100, 52, 55, 95, 53, 99, 120, 50, 100, 54, 100, 97, 54, 95, 53, 99, 120, 50, 100, 52, 48, 101, 52, 95, 53, 99, 120, 50, 100, 97, 100, 98, 99, 95, 53, 99, 120, 50, 100, 53, 52, 51, 52, 99, 99, 49, 101, 98, 97, 57, 101, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 49, 54, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 49, 54, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 54, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 49, 54, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 45, 46, 109, 111, 117, 110, 116, 0, 10, 0, 0, 0, 82, 111, 111, 116, 32, 77, 111, 117, 110, 116, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 95, 50, 100, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 100, 101, 118, 45, 115, 100, 98, 46, 100, 101, 118, 105, 99, 101, 0, 0, 15, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 32, 99, 105, 100, 97, 116, 97, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 78, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 49, 45, 50, 58, 48, 58, 49, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 98, 46, 100, 101, 118, 105, 99, 101, 0, 0, 49, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 115, 100, 98, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 50, 51, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 50, 51, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 109, 105, 115, 99, 45, 114, 102, 107, 105, 108, 108, 46, 100, 101, 118, 105, 99, 101, 0, 0, 32, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 118, 105, 114, 116, 117, 97, 108, 47, 109, 105, 115, 99, 47, 114, 102, 107, 105, 108, 108, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 79, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 118, 105, 114, 116, 117, 97, 108, 95, 50, 100, 109, 105, 115, 99, 95, 50, 100, 114, 102, 107, 105, 108, 108, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 109, 113, 117, 101, 117, 101, 46, 109, 111, 117, 110, 116, 0, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 109, 113, 117, 101, 117, 101, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 109, 113, 117, 101, 117, 101, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 55, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 55, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 55, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 55, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 57, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 42, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 57, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 91, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 57, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 54, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 42, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 54, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 91, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 54, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 50, 54, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 50, 54, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 54, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 50, 54, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 39, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 110, 112, 48, 45, 48, 48, 58, 48, 50, 45, 116, 116, 121, 45, 116, 116, 121, 83, 48, 46, 100, 101, 118, 105, 99, 101, 0, 33, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 110, 112, 48, 47, 48, 48, 58, 48, 50, 47, 116, 116, 121, 47, 116, 116, 121, 83, 48, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 84, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 110, 112, 48, 95, 50, 100, 48, 48, 95, 51, 97, 48, 50, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 50, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 50, 48, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 50, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 49, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 49, 56, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 49, 56, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 108, 111, 111, 112, 53, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 108, 111, 111, 112, 53, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 38, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 118, 105, 114, 116, 117, 97, 108, 45, 98, 108, 111, 99, 107, 45, 108, 111, 111, 112, 53, 46, 100, 101, 118, 105, 99, 101, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 53, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 39, 0, 0, 0, 115, 121, 115, 45, 115, 117, 98, 115, 121, 115, 116, 101, 109, 45, 110, 101, 116, 45, 100, 101, 118, 105, 99, 101, 115, 45, 101, 110, 112, 48, 115, 51, 46, 100, 101, 118, 105, 99, 101, 0, 65, 0, 0, 0, 56, 50, 53, 52, 48, 69, 77, 32, 71, 105, 103, 97, 98, 105, 116, 32, 69, 116, 104, 101, 114, 110, 101, 116, 32, 67, 111, 110, 116, 114, 111, 108, 108, 101, 114, 32, 40, 80, 82, 79, 47, 49, 48, 48, 48, 32, 77, 84, 32, 68, 101, 115, 107, 116, 111, 112, 32, 65, 100, 97, 112, 116, 101, 114, 41, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 80, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 115, 117, 98, 115, 121, 115, 116, 101, 109, 95, 50, 100, 110, 101, 116, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 101, 110, 112, 48, 115, 51, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 52, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 49, 52, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 49, 52, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 83, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 99, 105, 48, 48, 48, 48, 58, 48, 48, 45, 48, 48, 48, 48, 58, 48, 48, 58, 49, 52, 46, 48, 45, 104, 111, 115, 116, 50, 45, 116, 97, 114, 103, 101, 116, 50, 58, 48, 58, 48, 45, 50, 58, 48, 58, 48, 58, 48, 45, 98, 108, 111, 99, 107, 45, 115, 100, 97, 45, 115, 100, 97, 49, 46, 100, 101, 118, 105, 99, 101, 0, 24, 0, 0, 0, 72, 65, 82, 68, 68, 73, 83, 75, 32, 99, 108, 111, 117, 100, 105, 109, 103, 45, 114, 111, 111, 116, 102, 115, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 152, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 99, 105, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 50, 100, 48, 48, 48, 48, 95, 51, 97, 48, 48, 95, 51, 97, 49, 52, 95, 50, 101, 48, 95, 50, 100, 104, 111, 115, 116, 50, 95, 50, 100, 116, 97, 114, 103, 101, 116, 50, 95, 51, 97, 48, 95, 51, 97, 48, 95, 50, 100, 50, 95, 51, 97, 48, 95, 51, 97, 48, 95, 51, 97, 48, 95, 50, 100, 98, 108, 111, 99, 107, 95, 50, 100, 115, 100, 97, 95, 50, 100, 115, 100, 97, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 50, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 50, 56, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 50, 56, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 51, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 51, 48, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 51, 48, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 51, 48, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 50, 50, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 50, 50, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 50, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 50, 50, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 50, 57, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 11, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 50, 57, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 57, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 50, 57, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 105, 110, 105, 116, 46, 115, 99, 111, 112, 101, 0, 0, 26, 0, 0, 0, 83, 121, 115, 116, 101, 109, 32, 97, 110, 100, 32, 83, 101, 114, 118, 105, 99, 101, 32, 77, 97, 110, 97, 103, 101, 114, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 114, 117, 110, 110, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 0, 0, 43, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 105, 110, 105, 116, 95, 50, 101, 115, 99, 111, 112, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 55, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 25, 0, 0, 0, 98, 108, 111, 99, 107, 100, 101, 118, 64, 100, 101, 118, 45, 108, 111, 111, 112, 55, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 9, 0, 0, 0, 110, 111, 116, 45, 102, 111, 117, 110, 100, 0, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 62, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 98, 108, 111, 99, 107, 100, 101, 118, 95, 52, 48, 100, 101, 118, 95, 50, 100, 108, 111, 111, 112, 55, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 56, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 56, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 27, 0, 0, 0, 115, 110, 97, 112, 100, 46, 115, 101, 115, 115, 105, 111, 110, 45, 97, 103, 101, 110, 116, 46, 115, 101, 114, 118, 105, 99, 101, 0, 24, 0, 0, 0, 115, 110, 97, 112, 100, 32, 117, 115, 101, 114, 32, 115, 101, 115, 115, 105, 111, 110, 32, 97, 103, 101, 110, 116, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 110, 97, 112, 100, 95, 50, 101, 115, 101, 115, 115, 105, 111, 110, 95, 50, 100, 97, 103, 101, 110, 116, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 29, 0, 0, 0, 115, 121, 115, 45, 102, 115, 45, 102, 117, 115, 101, 45, 99, 111, 110, 110, 101, 99, 116, 105, 111, 110, 115, 46, 109, 111, 117, 110, 116, 0, 0, 0, 24, 0, 0, 0, 47, 115, 121, 115, 47, 102, 115, 47, 102, 117, 115, 101, 47, 99, 111, 110, 110, 101, 99, 116, 105, 111, 110, 115, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 68, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 102, 115, 95, 50, 100, 102, 117, 115, 101, 95, 50, 100, 99, 111, 110, 110, 101, 99, 116, 105, 111, 110, 115, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 49, 0, 0, 0, 114, 117, 110, 45, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 45, 115, 121, 115, 116, 101, 109, 100, 92, 120, 50, 100, 115, 121, 115, 117, 115, 101, 114, 115, 46, 115, 101, 114, 118, 105, 99, 101, 46, 109, 111, 117, 110, 116, 0, 0, 0, 41, 0, 0, 0, 47, 114, 117, 110, 47, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 47, 115, 121, 115, 116, 101, 109, 100, 45, 115, 121, 115, 117, 115, 101, 114, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 90, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 114, 117, 110, 95, 50, 100, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 95, 50, 100, 115, 121, 115, 116, 101, 109, 100, 95, 53, 99, 120, 50, 100, 115, 121, 115, 117, 115, 101, 114, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 14, 0, 0, 0, 100, 101, 102, 97, 117, 108, 116, 46, 116, 97, 114, 103, 101, 116, 0, 0, 16, 0, 0, 0, 77, 97, 105, 110, 32, 85, 115, 101, 114, 32, 84, 97, 114, 103, 101, 116, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 47, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 102, 97, 117, 108, 116, 95, 50, 101, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 51, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 51, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 51, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 49, 51, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 49, 51, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 52, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 42, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 52, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 91, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 52, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 16, 0, 0, 0, 100, 101, 118, 45, 116, 116, 121, 83, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 47, 100, 101, 118, 47, 116, 116, 121, 83, 49, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 48, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 49, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 51, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 101, 118, 95, 50, 100, 116, 116, 121, 83, 49, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 49, 0, 0, 0, 115, 121, 115, 45, 100, 101, 118, 105, 99, 101, 115, 45, 112, 108, 97, 116, 102, 111, 114, 109, 45, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 45, 116, 116, 121, 45, 116, 116, 121, 83, 50, 56, 46, 100, 101, 118, 105, 99, 101, 0, 0, 0, 43, 0, 0, 0, 47, 115, 121, 115, 47, 100, 101, 118, 105, 99, 101, 115, 47, 112, 108, 97, 116, 102, 111, 114, 109, 47, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 47, 116, 116, 121, 47, 116, 116, 121, 83, 50, 56, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 112, 108, 117, 103, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 115, 121, 115, 95, 50, 100, 100, 101, 118, 105, 99, 101, 115, 95, 50, 100, 112, 108, 97, 116, 102, 111, 114, 109, 95, 50, 100, 115, 101, 114, 105, 97, 108, 56, 50, 53, 48, 95, 50, 100, 116, 116, 121, 95, 50, 100, 116, 116, 121, 83, 50, 56, 95, 50, 101, 100, 101, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 118, 97, 103, 114, 97, 110, 116, 46, 109, 111, 117, 110, 116, 0, 0, 0, 8, 0, 0, 0, 47, 118, 97, 103, 114, 97, 110, 116, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 7, 0, 0, 0, 109, 111, 117, 110, 116, 101, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 46, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 118, 97, 103, 114, 97, 110, 116, 95, 50, 101, 109, 111, 117, 110, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 17, 0, 0, 0, 103, 112, 103, 45, 97, 103, 101, 110, 116, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 46, 0, 0, 0, 71, 110, 117, 80, 71, 32, 99, 114, 121, 112, 116, 111, 103, 114, 97, 112, 104, 105, 99, 32, 97, 103, 101, 110, 116, 32, 97, 110, 100, 32, 112, 97, 115, 115, 112, 104, 114, 97, 115, 101, 32, 99, 97, 99, 104, 101, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 101, 97, 100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 52, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 103, 112, 103, 95, 50, 100, 97, 103, 101, 110, 116, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+
+func TestEncodeUnitJob(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeUnitJob(conn, "StartUnit", "dbus.service", "replace", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(startUnitRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEncodeUnitJobFlags(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	msgEnc.Flags = flagNoAutoStart
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeUnitJob(conn, "StartUnit", "dbus.service", "replace", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var h header
+	dec := newDecoder(conn)
+	if err = decodeHeader(dec, newStringConverter(DefaultStringConverterSize), &h, true); err != nil {
+		t.Fatal(err)
+	}
+	if h.Flags != flagNoAutoStart {
+		t.Errorf("expected flags %d got %d", flagNoAutoStart, h.Flags)
+	}
+}
+
+func TestDecodeObjectPath(t *testing.T) {
+	conn := bytes.NewReader(startUnitResponse)
+	msgDec := newMessageDecoder()
+
+	got, err := msgDec.DecodeObjectPath(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "/org/freedesktop/systemd1/job/1292"
+	if want != got {
+		t.Errorf("expected job path %q got %q", want, got)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+func TestDecodeObjectPathError(t *testing.T) {
+	conn := bytes.NewReader(startUnitNoSuchUnitResponse)
+	msgDec := newMessageDecoder()
+
+	got, err := msgDec.DecodeObjectPath(conn)
+	errMsg := "Unit blah.service not found."
+	if err == nil || errMsg != err.Error() {
+		t.Fatalf("expected error %q got %q", errMsg, err)
+	}
+
+	if got != "" {
+		t.Errorf("expected empty job path got %q", got)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+func TestDecodeObjectPathErrorArgs(t *testing.T) {
+	conn := bytes.NewReader(startUnitNoSuchUnitArgsResponse)
+	msgDec := newMessageDecoder()
+
+	_, err := msgDec.DecodeObjectPath(conn)
+	if !errors.Is(err, ErrNoSuchUnit) {
+		t.Fatalf("expected ErrNoSuchUnit got %v", err)
+	}
+	errMsg := "Unit nope.service not found."
+	if errMsg != err.Error() {
+		t.Errorf("expected error %q got %q", errMsg, err)
+	}
+
+	// The arguments following the error message must be discarded
+	// so the next message could be decoded.
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+// startUnitRequest is a D-Bus message to start "dbus.service" in "replace" mode.
+var startUnitRequest = []byte{108, 1, 0, 1, 32, 0, 0, 0, 3, 0, 0, 0, 160, 0, 0, 0, 3, 1, 115, 0, 9, 0, 0, 0, 83, 116, 97, 114, 116, 85, 110, 105, 116, 0, 0, 0, 0, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 2, 115, 115, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 7, 0, 0, 0, 114, 101, 112, 108, 97, 99, 101, 0}
+
+// startUnitResponse is a reply to startUnitRequest that contains a job path.
+var startUnitResponse = []byte{108, 2, 1, 1, 39, 0, 0, 0, 216, 8, 0, 0, 45, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 1, 111, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 34, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 106, 111, 98, 47, 49, 50, 57, 50, 0}
+
+// startUnitNoSuchUnitResponse is an error reply to a request
+// to start an unknown unit "blah.service".
+var startUnitNoSuchUnitResponse = []byte{108, 3, 1, 1, 33, 0, 0, 0, 217, 8, 0, 0, 93, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 4, 1, 115, 0, 35, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 78, 111, 83, 117, 99, 104, 85, 110, 105, 116, 0, 0, 0, 0, 0, 8, 1, 103, 0, 1, 115, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 28, 0, 0, 0, 85, 110, 105, 116, 32, 98, 108, 97, 104, 46, 115, 101, 114, 118, 105, 99, 101, 32, 110, 111, 116, 32, 102, 111, 117, 110, 100, 46, 0}
+
+func TestDecodeGetUnitByPIDFD(t *testing.T) {
+	conn := bytes.NewReader(getUnitByPIDFDResponse)
+	msgDec := newMessageDecoder()
+
+	path, unitID, invocationID, err := msgDec.DecodeGetUnitByPIDFD(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"/org/freedesktop/systemd1/unit/dbus_2eservice",
+		"dbus.service",
+		"8b5f3c2e910d4a6ea127035ce49b811f",
+	}
+	if diff := cmp.Diff(want, []string{path, unitID, invocationID}); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+// getUnitByPIDFDRequest is a D-Bus message to look up a unit by pidfd
+// which is sent out-of-band.
+var getUnitByPIDFDRequest = []byte{108, 1, 0, 1, 4, 0, 0, 0, 3, 0, 0, 0, 168, 0, 0, 0, 3, 1, 115, 0, 14, 0, 0, 0, 71, 101, 116, 85, 110, 105, 116, 66, 121, 80, 73, 68, 70, 68, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 1, 104, 0, 0, 9, 1, 117, 0, 1, 0, 0, 0, 0, 0, 0, 0}
+
+// getUnitByPIDFDResponse is a reply to getUnitByPIDFDRequest
+// that contains the unit path, name, and invocation ID.
+var getUnitByPIDFDResponse = []byte{108, 2, 1, 1, 92, 0, 0, 0, 216, 8, 0, 0, 53, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 4, 111, 115, 97, 121, 0, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 16, 0, 0, 0, 139, 95, 60, 46, 145, 13, 74, 110, 161, 39, 3, 92, 228, 155, 129, 31}
+
+func TestEncodeGetProperty(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeGetProperty(conn, "dbus.service", "org.freedesktop.systemd1.Service", "MemoryAvailable", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(memoryAvailableRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDecodeUint64Property(t *testing.T) {
+	conn := bytes.NewReader(memoryAvailableResponse)
+	msgDec := newMessageDecoder()
+
+	got, err := msgDec.DecodeUint64Property(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want uint64 = 536870912
+	if want != got {
+		t.Errorf("expected %d got %d", want, got)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+func TestDecodeUint64PropertyError(t *testing.T) {
+	tt := map[string]struct {
+		in     []byte
+		errMsg string
+	}{
+		"unknown property": {
+			in:     mainPIDUnknownPropertyResponse,
+			errMsg: "Unknown interface org.freedesktop.systemd1.Service or property MainPID.",
+		},
+		"signature mismatch": {
+			in:     mainPIDResponse,
+			errMsg: "expected variant signature t, got u",
+		},
+	}
+
+	msgDec := newMessageDecoder()
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conn := bytes.NewReader(tc.in)
+
+			_, err := msgDec.DecodeUint64Property(conn)
+			if err == nil || tc.errMsg != err.Error() {
+				t.Fatalf("expected error %q got %q", tc.errMsg, err)
+			}
+
+			if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+				t.Errorf("conn has unread bytes")
+			}
+		})
+	}
+}
+
+// memoryAvailableRequest is a D-Bus message to request
+// the available memory of "dbus.service".
+var memoryAvailableRequest = []byte{108, 1, 0, 1, 60, 0, 0, 0, 3, 0, 0, 0, 160, 0, 0, 0, 1, 1, 111, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 3, 1, 115, 0, 3, 0, 0, 0, 71, 101, 116, 0, 0, 0, 0, 0, 2, 1, 115, 0, 31, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 0, 8, 1, 103, 0, 2, 115, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 83, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 15, 0, 0, 0, 77, 101, 109, 111, 114, 121, 65, 118, 97, 105, 108, 97, 98, 108, 101, 0}
+
+// memoryAvailableResponse is a reply to memoryAvailableRequest
+// that contains 512 MiB of available memory.
+var memoryAvailableResponse = []byte{108, 2, 1, 1, 16, 0, 0, 0, 216, 8, 0, 0, 45, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 1, 118, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 1, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 32, 0, 0, 0, 0}
+
+func TestDecodeErrorName(t *testing.T) {
+	tt := map[string]struct {
+		in      []byte
+		errName string
+		want    error
+	}{
+		"unknown property": {
+			in:      mainPIDUnknownPropertyResponse,
+			errName: "org.freedesktop.DBus.Error.UnknownProperty",
+			want:    ErrUnknownProperty,
+		},
+		"no such unit": {
+			in:      startUnitNoSuchUnitResponse,
+			errName: "org.freedesktop.systemd1.NoSuchUnit",
+			want:    ErrNoSuchUnit,
+		},
+		"unit masked": {
+			in:      startUnitMaskedResponse,
+			errName: "org.freedesktop.systemd1.UnitMasked",
+			want:    ErrUnitMasked,
+		},
+	}
+
+	msgDec := newMessageDecoder()
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conn := bytes.NewReader(tc.in)
+
+			_, err := msgDec.DecodeObjectPath(conn)
+			if !errors.Is(err, tc.want) {
+				t.Errorf("expected %v got %v", tc.want, err)
+			}
+
+			var dbusErr *DBusError
+			if !errors.As(err, &dbusErr) {
+				t.Fatalf("expected DBusError got %T", err)
+			}
+			if tc.errName != dbusErr.Name {
+				t.Errorf("expected error name %q got %q", tc.errName, dbusErr.Name)
+			}
+
+			if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+				t.Errorf("conn has unread bytes")
+			}
+		})
+	}
+}
+
+// startUnitMaskedResponse is an error reply to a request
+// to start a masked unit "dbus.service".
+var startUnitMaskedResponse = []byte{108, 3, 1, 1, 33, 0, 0, 0, 217, 8, 0, 0, 93, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 4, 1, 115, 0, 35, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 85, 110, 105, 116, 77, 97, 115, 107, 101, 100, 0, 0, 0, 0, 0, 8, 1, 103, 0, 1, 115, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 28, 0, 0, 0, 85, 110, 105, 116, 32, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 32, 105, 115, 32, 109, 97, 115, 107, 101, 100, 46, 0}
+
+func TestEncodeGetAllProperties(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeGetAllProperties(conn, "/org/freedesktop/systemd1/unit/dbus_2eservice", "org.freedesktop.systemd1.Service", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(getAllPropertiesRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDecodeEachProperty(t *testing.T) {
+	conn := bytes.NewReader(getAllPropertiesResponse)
+	msgDec := newMessageDecoder()
+
+	got := make(map[string]Variant)
+	err := msgDec.DecodeEachProperty(conn, func(name string, v Variant) bool {
+		got[name] = v
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]Variant{
+		"Type":            {Signature: "s", S: "notify"},
+		"RemainAfterExit": {Signature: "b", U: 0},
+		"MainPID":         {Signature: "u", U: 1241},
+		"OOMScoreAdjust":  {Signature: "i", I: -900},
+		"MemoryCurrent":   {Signature: "t", U: 4423680},
+		"Slice":           {Signature: "s", S: "system.slice"},
+		"ControlGroup":    {Signature: "s", S: "/system.slice/dbus.service"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+func TestDecodeEachPropertyStop(t *testing.T) {
+	conn := bytes.NewReader(getAllPropertiesResponse)
+	msgDec := newMessageDecoder()
+
+	var got []string
+	err := msgDec.DecodeEachProperty(conn, func(name string, v Variant) bool {
+		got = append(got, name)
+		return name != "MainPID"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"Type", "RemainAfterExit", "MainPID"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+func BenchmarkDecodeEachProperty(b *testing.B) {
+	conn := bytes.NewReader(getAllPropertiesResponse)
+	msgDec := newMessageDecoder()
+	var (
+		pid uint64
+		err error
+	)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		conn.Seek(0, io.SeekStart)
+		err = msgDec.DecodeEachProperty(conn, func(name string, v Variant) bool {
+			if name == "MainPID" {
+				pid = v.U
+				return false
+			}
+			return true
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	if pid != 1241 {
+		b.Errorf("expected pid 1241 got %d", pid)
+	}
+}
+
+// getAllPropertiesRequest is a D-Bus message to request
+// all the properties of org.freedesktop.systemd1.Service interface
+// from "dbus.service" object.
+var getAllPropertiesRequest = []byte{108, 1, 0, 1, 37, 0, 0, 0, 3, 0, 0, 0, 159, 0, 0, 0, 1, 1, 111, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 3, 1, 115, 0, 6, 0, 0, 0, 71, 101, 116, 65, 108, 108, 0, 0, 2, 1, 115, 0, 31, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 0, 8, 1, 103, 0, 1, 115, 0, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 83, 101, 114, 118, 105, 99, 101, 0}
+
+// getAllPropertiesResponse is a reply to getAllPropertiesRequest
+// that contains a subset of the service properties.
+var getAllPropertiesResponse = []byte{108, 2, 1, 1, 243, 0, 0, 0, 218, 8, 0, 0, 53, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 5, 97, 123, 115, 118, 125, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 235, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 84, 121, 112, 101, 0, 1, 115, 0, 6, 0, 0, 0, 110, 111, 116, 105, 102, 121, 0, 0, 15, 0, 0, 0, 82, 101, 109, 97, 105, 110, 65, 102, 116, 101, 114, 69, 120, 105, 116, 0, 1, 98, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 77, 97, 105, 110, 80, 73, 68, 0, 1, 117, 0, 0, 217, 4, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 79, 79, 77, 83, 99, 111, 114, 101, 65, 100, 106, 117, 115, 116, 0, 1, 105, 0, 0, 0, 124, 252, 255, 255, 0, 0, 0, 0, 13, 0, 0, 0, 77, 101, 109, 111, 114, 121, 67, 117, 114, 114, 101, 110, 116, 0, 1, 116, 0, 0, 0, 0, 0, 128, 67, 0, 0, 0, 0, 0, 5, 0, 0, 0, 83, 108, 105, 99, 101, 0, 1, 115, 0, 0, 0, 0, 12, 0, 0, 0, 115, 121, 115, 116, 101, 109, 46, 115, 108, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 67, 111, 110, 116, 114, 111, 108, 71, 114, 111, 117, 112, 0, 1, 115, 0, 26, 0, 0, 0, 47, 115, 121, 115, 116, 101, 109, 46, 115, 108, 105, 99, 101, 47, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0}
+
+func TestDecodeEachPropertyRaw(t *testing.T) {
+	conn := bytes.NewReader(servicePropertiesResponse)
+	msgDec := newMessageDecoder()
+
+	got := make(map[string]Variant)
+	err := msgDec.DecodeEachProperty(conn, func(name string, v Variant) bool {
+		got[name] = v
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]Variant{
+		"Id":    {Signature: "s", S: "dbus.service"},
+		"Names": {Signature: "as", Strings: []string{"dbus.service", "messagebus.service"}},
+		"Job": {Signature: "(uo)", Raw: &RawVariant{
+			Signature: "(uo)",
+			Bytes:     []byte{0, 0, 0, 0, 1, 0, 0, 0, 47, 0},
+		}},
+		"LoadError":   {Signature: "(ss)", Strings: []string{"", ""}},
+		"ActiveState": {Signature: "s", S: "active"},
+		"MainPID":     {Signature: "u", U: 1241},
+	}
+	// ExecStart is checked separately because of its size.
+	execStart := got["ExecStart"]
+	delete(got, "ExecStart")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+
+	if execStart.Raw == nil || execStart.Raw.Signature != "a(sasbttttuii)" || len(execStart.Raw.Bytes) != 124 {
+		t.Errorf("unexpected ExecStart %+v", execStart)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+// servicePropertiesResponse is a reply to GetAll method
+// that contains properties of various types
+// including the ones that aren't fully decoded,
+// e.g., ExecStart "a(sasbttttuii)".
+var servicePropertiesResponse = []byte{108, 2, 1, 1, 140, 1, 0, 0, 219, 8, 0, 0, 53, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 5, 97, 123, 115, 118, 125, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 132, 1, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 73, 100, 0, 1, 115, 0, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 5, 0, 0, 0, 78, 97, 109, 101, 115, 0, 2, 97, 115, 0, 0, 0, 43, 0, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 18, 0, 0, 0, 109, 101, 115, 115, 97, 103, 101, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 3, 0, 0, 0, 74, 111, 98, 0, 4, 40, 117, 111, 41, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0, 76, 111, 97, 100, 69, 114, 114, 111, 114, 0, 4, 40, 115, 115, 41, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 65, 99, 116, 105, 118, 101, 83, 116, 97, 116, 101, 0, 1, 115, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 9, 0, 0, 0, 69, 120, 101, 99, 83, 116, 97, 114, 116, 0, 14, 97, 40, 115, 97, 115, 98, 116, 116, 116, 116, 117, 105, 105, 41, 0, 0, 0, 124, 0, 0, 0, 0, 0, 0, 0, 20, 0, 0, 0, 47, 117, 115, 114, 47, 98, 105, 110, 47, 100, 98, 117, 115, 45, 100, 97, 101, 109, 111, 110, 0, 0, 0, 0, 41, 0, 0, 0, 20, 0, 0, 0, 47, 117, 115, 114, 47, 98, 105, 110, 47, 100, 98, 117, 115, 45, 100, 97, 101, 109, 111, 110, 0, 0, 0, 0, 8, 0, 0, 0, 45, 45, 115, 121, 115, 116, 101, 109, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64, 30, 24, 36, 10, 6, 0, 78, 97, 188, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 217, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 77, 97, 105, 110, 80, 73, 68, 0, 1, 117, 0, 0, 217, 4, 0, 0}
+
+func TestDecodeBodyAlignment(t *testing.T) {
+	want := map[string]Variant{
+		"Names":         {Signature: "as", Strings: []string{"dbus.service"}},
+		"MemoryCurrent": {Signature: "t", U: 524288},
+		"Id":            {Signature: "s", S: "dbus.service"},
+	}
+
+	// The destination name changes the header length,
+	// hence the header padding before the body is 0-7 bytes.
+	for n := 1; n <= 8; n++ {
+		msgEnc := newMessageEncoder()
+		h := header{
+			ByteOrder: littleEndian,
+			Type:      msgTypeMethodReply,
+			Proto:     1,
+			Serial:    2,
+			Fields: []headerField{
+				{Signature: "u", U: 3, Code: fieldReplySerial},
+				{Signature: "s", S: ":" + strings.Repeat("1", n), Code: fieldDestination},
+				{Signature: "g", S: "a{sv}", Code: fieldSignature},
+			},
+		}
+		conn := &bytes.Buffer{}
+		err := msgEnc.encode(conn, &h, func(e *encoder) {
+			arrLenOffset := e.Offset()
+			e.Uint32(0)
+			e.Align(8)
+			arrStart := e.Offset()
+
+			e.Align(8)
+			e.String("Names")
+			e.Signature("as")
+			e.StringArray([]string{"dbus.service"})
+
+			// The uint64 is aligned to 8 bytes after the odd-length entry.
+			e.Align(8)
+			e.String("MemoryCurrent")
+			e.Signature("t")
+			e.Align(8)
+			e.Uint32(524288)
+			e.Uint32(0)
+
+			e.Align(8)
+			e.String("Id")
+			e.Signature("s")
+			e.String("dbus.service")
+
+			e.Uint32At(e.Offset()-arrStart, arrLenOffset)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		msgLen := uint32(conn.Len())
+
+		msgDec := newMessageDecoder()
+		got := make(map[string]Variant)
+		err = msgDec.DecodeEachProperty(conn, func(name string, v Variant) bool {
+			got[name] = v
+			return true
+		})
+		if err != nil {
+			t.Fatalf("destination length %d: %v", n, err)
+		}
+
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("destination length %d: %s", n, diff)
+		}
+		// The decoder's offset is the position in the message, not in the body.
+		if msgDec.Dec.Offset() != msgLen {
+			t.Errorf("destination length %d: expected offset %d got %d", n, msgLen, msgDec.Dec.Offset())
+		}
+	}
+}
+
+func TestDecodeSystemStatus(t *testing.T) {
+	conn := bytes.NewReader(managerPropertiesResponse)
+	msgDec := newMessageDecoder()
+
+	var got SystemStatus
+	err := msgDec.DecodeEachProperty(conn, func(name string, v Variant) bool {
+		got.set(name, v)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := SystemStatus{
+		Version:            "255.4-1ubuntu8",
+		Features:           "+PAM +AUDIT +SELINUX",
+		Virtualization:     "kvm",
+		Architecture:       "x86-64",
+		SystemState:        "degraded",
+		NNames:             291,
+		NFailedUnits:       1,
+		NInstalledJobs:     187,
+		Progress:           1,
+		UserspaceTimestamp: 1712144416307561,
+		FinishTimestamp:    1712144421563020,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestSystemStatusSetUnexpectedType(t *testing.T) {
+	s := SystemStatus{NJobs: 3}
+	s.set("NJobs", Variant{Signature: "s", S: "many"})
+	s.set("Unknown", Variant{Signature: "u", U: 1})
+
+	if diff := cmp.Diff(SystemStatus{NJobs: 3}, s); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEncodeListUnitsByPatterns(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeListUnitsByPatterns(conn, []string{"failed"}, []string{"ssh*", "dbus.service"}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(listUnitsByPatternsRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEncodeListUnitsByPatternsEmpty(t *testing.T) {
+	tt := map[string]struct {
+		states   []string
+		patterns []string
+		wantBody []byte
+	}{
+		"nil": {
+			wantBody: []byte{0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		"empty": {
+			states:   []string{},
+			patterns: []string{},
+			wantBody: []byte{0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		"all states": {
+			patterns: []string{"ssh*"},
+			wantBody: []byte{0, 0, 0, 0, 9, 0, 0, 0, 4, 0, 0, 0, 's', 's', 'h', '*', 0},
+		},
+		"all names": {
+			states:   []string{"failed"},
+			wantBody: []byte{11, 0, 0, 0, 6, 0, 0, 0, 'f', 'a', 'i', 'l', 'e', 'd', 0, 0, 0, 0, 0, 0},
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			msgEnc := newMessageEncoder()
+			conn := &bytes.Buffer{}
+			err := msgEnc.EncodeListUnitsByPatterns(conn, tc.states, tc.patterns, 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Both arrays are present even if they are empty,
+			// otherwise systemd rejects the call.
+			var h header
+			dec := newDecoder(bytes.NewReader(conn.Bytes()))
+			if err = decodeHeader(dec, newStringConverter(DefaultStringConverterSize), &h, false); err != nil {
+				t.Fatal(err)
+			}
+			if sig := h.stringField(fieldSignature); sig != "asas" {
+				t.Errorf("expected signature asas got %q", sig)
+			}
+
+			body := conn.Bytes()[h.Len():]
+			if diff := cmp.Diff(tc.wantBody, body); diff != "" {
+				t.Error(diff)
+			}
+			if h.BodyLen != uint32(len(body)) {
+				t.Errorf("expected body length %d got %d", len(body), h.BodyLen)
+			}
+		})
+	}
+}
+
+// listUnitsByPatternsRequest is a D-Bus message to request
+// the failed units whose names match "ssh*" or "dbus.service".
+var listUnitsByPatternsRequest = []byte{108, 1, 0, 1, 49, 0, 0, 0, 3, 0, 0, 0, 170, 0, 0, 0, 3, 1, 115, 0, 19, 0, 0, 0, 76, 105, 115, 116, 85, 110, 105, 116, 115, 66, 121, 80, 97, 116, 116, 101, 114, 110, 115, 0, 0, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 4, 97, 115, 97, 115, 0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 6, 0, 0, 0, 102, 97, 105, 108, 101, 100, 0, 0, 29, 0, 0, 0, 4, 0, 0, 0, 115, 115, 104, 42, 0, 0, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0}
+
+func TestEncodeGetAllUnitProperties(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeGetAllUnitProperties(conn, "dbus.service", "org.freedesktop.systemd1.Unit", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(unitPropertiesRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDecodeUnitProperties(t *testing.T) {
+	conn := bytes.NewReader(unitPropertiesResponse)
+	msgDec := newMessageDecoder()
+
+	var n int
+	err := msgDec.DecodeEachProperty(conn, func(name string, v Variant) bool {
+		n++
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := 101; want != n {
+		t.Errorf("expected %d properties got %d", want, n)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+// BenchmarkDecodeUnitState decodes the states of "dbus.service"
+// from org.freedesktop.systemd1.Unit properties
+// stopping once the states are found, see Client.UnitState.
+func BenchmarkDecodeUnitState(b *testing.B) {
+	conn := bytes.NewReader(unitPropertiesResponse)
+	msgDec := newMessageDecoder()
+	var (
+		active, sub, load string
+		err               error
+	)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		conn.Seek(0, io.SeekStart)
+		active, sub, load = "", "", ""
+		err = msgDec.DecodeEachProperty(conn, func(name string, v Variant) bool {
+			switch name {
+			case "ActiveState":
+				active = v.S
+			case "SubState":
+				sub = v.S
+			case "LoadState":
+				load = v.S
+			}
+			return active == "" || sub == "" || load == ""
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	if active != "active" || sub != "running" || load != "loaded" {
+		b.Errorf("unexpected states %q %q %q", active, sub, load)
+	}
+}
+
+// BenchmarkDecodeUnitStateListUnits decodes the states of "dbus.service"
+// by filtering ListUnits reply, so it can be compared with BenchmarkDecodeUnitState.
+func BenchmarkDecodeUnitStateListUnits(b *testing.B) {
+	conn := bytes.NewReader(listUnitsResponse)
+	msgDec := newMessageDecoder()
+	name := []byte("dbus.service")
+	p := func(fieldIndex int, s []byte) bool {
+		return fieldIndex != 0 || bytes.Equal(s, name)
+	}
+	var (
+		active, sub, load string
+		err               error
+	)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		conn.Seek(0, io.SeekStart)
+		err = msgDec.DecodeListUnits(conn, p, func(u *Unit) {
+			active, sub, load = u.ActiveState, u.SubState, u.LoadState
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	if active != "active" || sub != "running" || load != "loaded" {
+		b.Errorf("unexpected states %q %q %q", active, sub, load)
+	}
+}
+
+// unitPropertiesRequest is a D-Bus message to request
+// all the properties of org.freedesktop.systemd1.Unit interface
+// from "dbus.service" object.
+var unitPropertiesRequest = []byte{108, 1, 0, 1, 34, 0, 0, 0, 3, 0, 0, 0, 159, 0, 0, 0, 1, 1, 111, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 3, 1, 115, 0, 6, 0, 0, 0, 71, 101, 116, 65, 108, 108, 0, 0, 2, 1, 115, 0, 31, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 0, 8, 1, 103, 0, 1, 115, 0, 0, 29, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 85, 110, 105, 116, 0}
+
+// unitPropertiesResponse is a reply to unitPropertiesRequest.
+var unitPropertiesResponse = []byte{108, 2, 1, 1, 40, 14, 0, 0, 220, 8, 0, 0, 53, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 5, 97, 123, 115, 118, 125, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 32, 14, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 73, 100, 0, 1, 115, 0, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 5, 0, 0, 0, 78, 97, 109, 101, 115, 0, 2, 97, 115, 0, 0, 0, 43, 0, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 18, 0, 0, 0, 109, 101, 115, 115, 97, 103, 101, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 9, 0, 0, 0, 70, 111, 108, 108, 111, 119, 105, 110, 103, 0, 1, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 82, 101, 113, 117, 105, 114, 101, 115, 0, 2, 97, 115, 0, 0, 0, 0, 55, 0, 0, 0, 12, 0, 0, 0, 115, 121, 115, 116, 101, 109, 46, 115, 108, 105, 99, 101, 0, 0, 0, 0, 11, 0, 0, 0, 100, 98, 117, 115, 46, 115, 111, 99, 107, 101, 116, 0, 14, 0, 0, 0, 115, 121, 115, 105, 110, 105, 116, 46, 116, 97, 114, 103, 101, 116, 0, 0, 9, 0, 0, 0, 82, 101, 113, 117, 105, 115, 105, 116, 101, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 87, 97, 110, 116, 115, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 66, 105, 110, 100, 115, 84, 111, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 0, 0, 80, 97, 114, 116, 79, 102, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 85, 112, 104, 111, 108, 100, 115, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 82, 101, 113, 117, 105, 114, 101, 100, 66, 121, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 82, 101, 113, 117, 105, 115, 105, 116, 101, 79, 102, 0, 2, 97, 115, 0, 0, 0, 0, 0, 8, 0, 0, 0, 87, 97, 110, 116, 101, 100, 66, 121, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 66, 111, 117, 110, 100, 66, 121, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 85, 112, 104, 101, 108, 100, 66, 121, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 67, 111, 110, 115, 105, 115, 116, 115, 79, 102, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0, 67, 111, 110, 102, 108, 105, 99, 116, 115, 0, 2, 97, 115, 0, 0, 0, 20, 0, 0, 0, 15, 0, 0, 0, 115, 104, 117, 116, 100, 111, 119, 110, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 0, 12, 0, 0, 0, 67, 111, 110, 102, 108, 105, 99, 116, 101, 100, 66, 121, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 0, 0, 66, 101, 102, 111, 114, 101, 0, 2, 97, 115, 0, 0, 44, 0, 0, 0, 17, 0, 0, 0, 109, 117, 108, 116, 105, 45, 117, 115, 101, 114, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 15, 0, 0, 0, 115, 104, 117, 116, 100, 111, 119, 110, 46, 116, 97, 114, 103, 101, 116, 0, 5, 0, 0, 0, 65, 102, 116, 101, 114, 0, 2, 97, 115, 0, 0, 0, 104, 0, 0, 0, 12, 0, 0, 0, 115, 121, 115, 116, 101, 109, 46, 115, 108, 105, 99, 101, 0, 0, 0, 0, 11, 0, 0, 0, 100, 98, 117, 115, 46, 115, 111, 99, 107, 101, 116, 0, 12, 0, 0, 0, 98, 97, 115, 105, 99, 46, 116, 97, 114, 103, 101, 116, 0, 0, 0, 0, 14, 0, 0, 0, 115, 121, 115, 105, 110, 105, 116, 46, 116, 97, 114, 103, 101, 116, 0, 0, 23, 0, 0, 0, 115, 121, 115, 116, 101, 109, 100, 45, 106, 111, 117, 114, 110, 97, 108, 100, 46, 115, 111, 99, 107, 101, 116, 0, 0, 0, 0, 0, 9, 0, 0, 0, 79, 110, 83, 117, 99, 99, 101, 115, 115, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 79, 110, 83, 117, 99, 99, 101, 115, 115, 79, 102, 0, 2, 97, 115, 0, 0, 0, 0, 0, 9, 0, 0, 0, 79, 110, 70, 97, 105, 108, 117, 114, 101, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 79, 110, 70, 97, 105, 108, 117, 114, 101, 79, 102, 0, 2, 97, 115, 0, 0, 0, 0, 0, 8, 0, 0, 0, 84, 114, 105, 103, 103, 101, 114, 115, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 84, 114, 105, 103, 103, 101, 114, 101, 100, 66, 121, 0, 2, 97, 115, 0, 16, 0, 0, 0, 11, 0, 0, 0, 100, 98, 117, 115, 46, 115, 111, 99, 107, 101, 116, 0, 18, 0, 0, 0, 80, 114, 111, 112, 97, 103, 97, 116, 101, 115, 82, 101, 108, 111, 97, 100, 84, 111, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 20, 0, 0, 0, 82, 101, 108, 111, 97, 100, 80, 114, 111, 112, 97, 103, 97, 116, 101, 100, 70, 114, 111, 109, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 80, 114, 111, 112, 97, 103, 97, 116, 101, 115, 83, 116, 111, 112, 84, 111, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 18, 0, 0, 0, 83, 116, 111, 112, 80, 114, 111, 112, 97, 103, 97, 116, 101, 100, 70, 114, 111, 109, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 74, 111, 105, 110, 115, 78, 97, 109, 101, 115, 112, 97, 99, 101, 79, 102, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 83, 108, 105, 99, 101, 79, 102, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 82, 101, 113, 117, 105, 114, 101, 115, 77, 111, 117, 110, 116, 115, 70, 111, 114, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 87, 97, 110, 116, 115, 77, 111, 117, 110, 116, 115, 70, 111, 114, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 68, 111, 99, 117, 109, 101, 110, 116, 97, 116, 105, 111, 110, 0, 2, 97, 115, 0, 0, 0, 23, 0, 0, 0, 18, 0, 0, 0, 109, 97, 110, 58, 100, 98, 117, 115, 45, 100, 97, 101, 109, 111, 110, 40, 49, 41, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 68, 101, 115, 99, 114, 105, 112, 116, 105, 111, 110, 0, 1, 115, 0, 0, 24, 0, 0, 0, 68, 45, 66, 117, 115, 32, 83, 121, 115, 116, 101, 109, 32, 77, 101, 115, 115, 97, 103, 101, 32, 66, 117, 115, 0, 0, 0, 0, 0, 0, 0, 0, 20, 0, 0, 0, 65, 99, 99, 101, 115, 115, 83, 69, 76, 105, 110, 117, 120, 67, 111, 110, 116, 101, 120, 116, 0, 1, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0, 76, 111, 97, 100, 83, 116, 97, 116, 101, 0, 1, 115, 0, 0, 0, 0, 6, 0, 0, 0, 108, 111, 97, 100, 101, 100, 0, 0, 11, 0, 0, 0, 65, 99, 116, 105, 118, 101, 83, 116, 97, 116, 101, 0, 1, 115, 0, 0, 6, 0, 0, 0, 97, 99, 116, 105, 118, 101, 0, 0, 12, 0, 0, 0, 70, 114, 101, 101, 122, 101, 114, 83, 116, 97, 116, 101, 0, 1, 115, 0, 7, 0, 0, 0, 114, 117, 110, 110, 105, 110, 103, 0, 8, 0, 0, 0, 83, 117, 98, 83, 116, 97, 116, 101, 0, 1, 115, 0, 7, 0, 0, 0, 114, 117, 110, 110, 105, 110, 103, 0, 0, 0, 0, 0, 12, 0, 0, 0, 70, 114, 97, 103, 109, 101, 110, 116, 80, 97, 116, 104, 0, 1, 115, 0, 36, 0, 0, 0, 47, 117, 115, 114, 47, 108, 105, 98, 47, 115, 121, 115, 116, 101, 109, 100, 47, 115, 121, 115, 116, 101, 109, 47, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 10, 0, 0, 0, 83, 111, 117, 114, 99, 101, 80, 97, 116, 104, 0, 1, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 68, 114, 111, 112, 73, 110, 80, 97, 116, 104, 115, 0, 2, 97, 115, 0, 0, 0, 0, 0, 13, 0, 0, 0, 85, 110, 105, 116, 70, 105, 108, 101, 83, 116, 97, 116, 101, 0, 1, 115, 0, 0, 0, 0, 6, 0, 0, 0, 115, 116, 97, 116, 105, 99, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 85, 110, 105, 116, 70, 105, 108, 101, 80, 114, 101, 115, 101, 116, 0, 1, 115, 0, 0, 0, 7, 0, 0, 0, 101, 110, 97, 98, 108, 101, 100, 0, 0, 0, 0, 0, 20, 0, 0, 0, 83, 116, 97, 116, 101, 67, 104, 97, 110, 103, 101, 84, 105, 109, 101, 115, 116, 97, 109, 112, 0, 1, 116, 0, 0, 0, 0, 0, 78, 161, 218, 24, 36, 10, 6, 0, 29, 0, 0, 0, 83, 116, 97, 116, 101, 67, 104, 97, 110, 103, 101, 84, 105, 109, 101, 115, 116, 97, 109, 112, 77, 111, 110, 111, 116, 111, 110, 105, 99, 0, 1, 116, 0, 0, 0, 0, 78, 97, 188, 0, 0, 0, 0, 0, 21, 0, 0, 0, 73, 110, 97, 99, 116, 105, 118, 101, 69, 120, 105, 116, 84, 105, 109, 101, 115, 116, 97, 109, 112, 0, 1, 116, 0, 0, 0, 0, 224, 238, 217, 24, 36, 10, 6, 0, 30, 0, 0, 0, 73, 110, 97, 99, 116, 105, 118, 101, 69, 120, 105, 116, 84, 105, 109, 101, 115, 116, 97, 109, 112, 77, 111, 110, 111, 116, 111, 110, 105, 99, 0, 1, 116, 0, 0, 0, 224, 174, 187, 0, 0, 0, 0, 0, 20, 0, 0, 0, 65, 99, 116, 105, 118, 101, 69, 110, 116, 101, 114, 84, 105, 109, 101, 115, 116, 97, 109, 112, 0, 1, 116, 0, 0, 0, 0, 0, 78, 161, 218, 24, 36, 10, 6, 0, 29, 0, 0, 0, 65, 99, 116, 105, 118, 101, 69, 110, 116, 101, 114, 84, 105, 109, 101, 115, 116, 97, 109, 112, 77, 111, 110, 111, 116, 111, 110, 105, 99, 0, 1, 116, 0, 0, 0, 0, 78, 97, 188, 0, 0, 0, 0, 0, 19, 0, 0, 0, 65, 99, 116, 105, 118, 101, 69, 120, 105, 116, 84, 105, 109, 101, 115, 116, 97, 109, 112, 0, 1, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 28, 0, 0, 0, 65, 99, 116, 105, 118, 101, 69, 120, 105, 116, 84, 105, 109, 101, 115, 116, 97, 109, 112, 77, 111, 110, 111, 116, 111, 110, 105, 99, 0, 1, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 22, 0, 0, 0, 73, 110, 97, 99, 116, 105, 118, 101, 69, 110, 116, 101, 114, 84, 105, 109, 101, 115, 116, 97, 109, 112, 0, 1, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 31, 0, 0, 0, 73, 110, 97, 99, 116, 105, 118, 101, 69, 110, 116, 101, 114, 84, 105, 109, 101, 115, 116, 97, 109, 112, 77, 111, 110, 111, 116, 111, 110, 105, 99, 0, 1, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 67, 97, 110, 83, 116, 97, 114, 116, 0, 1, 98, 0, 1, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 67, 97, 110, 83, 116, 111, 112, 0, 1, 98, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0, 67, 97, 110, 82, 101, 108, 111, 97, 100, 0, 1, 98, 0, 0, 0, 0, 1, 0, 0, 0, 10, 0, 0, 0, 67, 97, 110, 73, 115, 111, 108, 97, 116, 101, 0, 1, 98, 0, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 67, 97, 110, 67, 108, 101, 97, 110, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0, 67, 97, 110, 70, 114, 101, 101, 122, 101, 0, 1, 98, 0, 0, 0, 0, 1, 0, 0, 0, 12, 0, 0, 0, 67, 97, 110, 76, 105, 118, 101, 77, 111, 117, 110, 116, 0, 1, 98, 0, 0, 0, 0, 0, 3, 0, 0, 0, 74, 111, 98, 0, 4, 40, 117, 111, 41, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 83, 116, 111, 112, 87, 104, 101, 110, 85, 110, 110, 101, 101, 100, 101, 100, 0, 1, 98, 0, 0, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 82, 101, 102, 117, 115, 101, 77, 97, 110, 117, 97, 108, 83, 116, 97, 114, 116, 0, 1, 98, 0, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 82, 101, 102, 117, 115, 101, 77, 97, 110, 117, 97, 108, 83, 116, 111, 112, 0, 1, 98, 0, 1, 0, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 65, 108, 108, 111, 119, 73, 115, 111, 108, 97, 116, 101, 0, 1, 98, 0, 0, 0, 0, 0, 19, 0, 0, 0, 68, 101, 102, 97, 117, 108, 116, 68, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 0, 1, 98, 0, 0, 0, 0, 0, 0, 22, 0, 0, 0, 83, 117, 114, 118, 105, 118, 101, 70, 105, 110, 97, 108, 75, 105, 108, 108, 83, 105, 103, 110, 97, 108, 0, 1, 98, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 79, 110, 83, 117, 99, 99, 101, 115, 115, 74, 111, 98, 77, 111, 100, 101, 0, 1, 115, 0, 4, 0, 0, 0, 102, 97, 105, 108, 0, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 79, 110, 70, 97, 105, 108, 117, 114, 101, 74, 111, 98, 77, 111, 100, 101, 0, 1, 115, 0, 7, 0, 0, 0, 114, 101, 112, 108, 97, 99, 101, 0, 0, 0, 0, 0, 15, 0, 0, 0, 73, 103, 110, 111, 114, 101, 79, 110, 73, 115, 111, 108, 97, 116, 101, 0, 1, 98, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 78, 101, 101, 100, 68, 97, 101, 109, 111, 110, 82, 101, 108, 111, 97, 100, 0, 1, 98, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 77, 97, 114, 107, 101, 114, 115, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 74, 111, 98, 84, 105, 109, 101, 111, 117, 116, 85, 83, 101, 99, 0, 1, 116, 0, 0, 0, 255, 255, 255, 255, 255, 255, 255, 255, 21, 0, 0, 0, 74, 111, 98, 82, 117, 110, 110, 105, 110, 103, 84, 105, 109, 101, 111, 117, 116, 85, 83, 101, 99, 0, 1, 116, 0, 0, 0, 0, 255, 255, 255, 255, 255, 255, 255, 255, 16, 0, 0, 0, 74, 111, 98, 84, 105, 109, 101, 111, 117, 116, 65, 99, 116, 105, 111, 110, 0, 1, 115, 0, 4, 0, 0, 0, 110, 111, 110, 101, 0, 0, 0, 0, 0, 0, 0, 0, 24, 0, 0, 0, 74, 111, 98, 84, 105, 109, 101, 111, 117, 116, 82, 101, 98, 111, 111, 116, 65, 114, 103, 117, 109, 101, 110, 116, 0, 1, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 15, 0, 0, 0, 67, 111, 110, 100, 105, 116, 105, 111, 110, 82, 101, 115, 117, 108, 116, 0, 1, 98, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 65, 115, 115, 101, 114, 116, 82, 101, 115, 117, 108, 116, 0, 1, 98, 0, 1, 0, 0, 0, 18, 0, 0, 0, 67, 111, 110, 100, 105, 116, 105, 111, 110, 84, 105, 109, 101, 115, 116, 97, 109, 112, 0, 1, 116, 0, 0, 0, 0, 0, 0, 0, 64, 104, 216, 24, 36, 10, 6, 0, 27, 0, 0, 0, 67, 111, 110, 100, 105, 116, 105, 111, 110, 84, 105, 109, 101, 115, 116, 97, 109, 112, 77, 111, 110, 111, 116, 111, 110, 105, 99, 0, 1, 116, 0, 0, 0, 0, 0, 0, 64, 40, 186, 0, 0, 0, 0, 0, 15, 0, 0, 0, 65, 115, 115, 101, 114, 116, 84, 105, 109, 101, 115, 116, 97, 109, 112, 0, 1, 116, 0, 0, 64, 104, 216, 24, 36, 10, 6, 0, 24, 0, 0, 0, 65, 115, 115, 101, 114, 116, 84, 105, 109, 101, 115, 116, 97, 109, 112, 77, 111, 110, 111, 116, 111, 110, 105, 99, 0, 1, 116, 0, 64, 40, 186, 0, 0, 0, 0, 0, 10, 0, 0, 0, 67, 111, 110, 100, 105, 116, 105, 111, 110, 115, 0, 8, 97, 40, 115, 98, 98, 115, 105, 41, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 65, 115, 115, 101, 114, 116, 115, 0, 8, 97, 40, 115, 98, 98, 115, 105, 41, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0, 76, 111, 97, 100, 69, 114, 114, 111, 114, 0, 4, 40, 115, 115, 41, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0, 84, 114, 97, 110, 115, 105, 101, 110, 116, 0, 1, 98, 0, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0, 80, 101, 114, 112, 101, 116, 117, 97, 108, 0, 1, 98, 0, 0, 0, 0, 0, 0, 0, 0, 22, 0, 0, 0, 83, 116, 97, 114, 116, 76, 105, 109, 105, 116, 73, 110, 116, 101, 114, 118, 97, 108, 85, 83, 101, 99, 0, 1, 116, 0, 0, 0, 128, 150, 152, 0, 0, 0, 0, 0, 15, 0, 0, 0, 83, 116, 97, 114, 116, 76, 105, 109, 105, 116, 66, 117, 114, 115, 116, 0, 1, 117, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 83, 116, 97, 114, 116, 76, 105, 109, 105, 116, 65, 99, 116, 105, 111, 110, 0, 1, 115, 0, 4, 0, 0, 0, 110, 111, 110, 101, 0, 0, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 70, 97, 105, 108, 117, 114, 101, 65, 99, 116, 105, 111, 110, 0, 1, 115, 0, 0, 0, 0, 4, 0, 0, 0, 110, 111, 110, 101, 0, 0, 0, 0, 0, 0, 0, 0, 23, 0, 0, 0, 70, 97, 105, 108, 117, 114, 101, 65, 99, 116, 105, 111, 110, 69, 120, 105, 116, 83, 116, 97, 116, 117, 115, 0, 1, 105, 0, 0, 255, 255, 255, 255, 0, 0, 0, 0, 13, 0, 0, 0, 83, 117, 99, 99, 101, 115, 115, 65, 99, 116, 105, 111, 110, 0, 1, 115, 0, 0, 0, 0, 4, 0, 0, 0, 110, 111, 110, 101, 0, 0, 0, 0, 0, 0, 0, 0, 23, 0, 0, 0, 83, 117, 99, 99, 101, 115, 115, 65, 99, 116, 105, 111, 110, 69, 120, 105, 116, 83, 116, 97, 116, 117, 115, 0, 1, 105, 0, 0, 255, 255, 255, 255, 0, 0, 0, 0, 14, 0, 0, 0, 82, 101, 98, 111, 111, 116, 65, 114, 103, 117, 109, 101, 110, 116, 0, 1, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 73, 110, 118, 111, 99, 97, 116, 105, 111, 110, 73, 68, 0, 2, 97, 121, 0, 0, 0, 0, 16, 0, 0, 0, 0, 17, 34, 51, 68, 85, 102, 119, 136, 153, 170, 187, 204, 221, 238, 255, 0, 0, 0, 0, 11, 0, 0, 0, 67, 111, 108, 108, 101, 99, 116, 77, 111, 100, 101, 0, 1, 115, 0, 0, 8, 0, 0, 0, 105, 110, 97, 99, 116, 105, 118, 101, 0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 82, 101, 102, 115, 0, 2, 97, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 65, 99, 116, 105, 118, 97, 116, 105, 111, 110, 68, 101, 116, 97, 105, 108, 115, 0, 5, 97, 40, 115, 115, 41, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+
+func TestEncodePing(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodePing(conn, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(pingRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDecodeEmptyReply(t *testing.T) {
+	conn := io.MultiReader(
+		bytes.NewReader(nameAcquiredSignal),
+		bytes.NewReader(pingResponse),
+	)
+	msgDec := newMessageDecoder()
+
+	if err := msgDec.DecodeEmptyReply(conn); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.Read(make([]byte, 1)); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+// pingRequest is a D-Bus message to ping the message bus.
+var pingRequest = []byte{108, 1, 0, 1, 0, 0, 0, 0, 3, 0, 0, 0, 118, 0, 0, 0, 6, 1, 115, 0, 20, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 0, 0, 0, 0, 3, 1, 115, 0, 4, 0, 0, 0, 80, 105, 110, 103, 0, 0, 0, 0, 2, 1, 115, 0, 25, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 80, 101, 101, 114, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 21, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 68, 66, 117, 115, 0, 0, 0}
+
+// pingResponse is a reply to pingRequest which has no body.
+var pingResponse = []byte{108, 2, 1, 1, 0, 0, 0, 0, 221, 8, 0, 0, 37, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0}
+
+func TestEncodeManagerCall(t *testing.T) {
+	tt := map[string]struct {
+		member string
+		serial uint32
+		want   []byte
+	}{
+		"Reload":    {"Reload", 3, reloadRequest},
+		"ListUnits": {"ListUnits", 2, listUnitsRequest},
+	}
+
+	msgEnc := newMessageEncoder()
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conn := &bytes.Buffer{}
+			if err := msgEnc.encodeManagerCall(conn, tc.member, tc.serial); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.want, conn.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+// reloadRequest is a D-Bus message to reload the systemd manager's configuration.
+var reloadRequest = []byte{108, 1, 0, 1, 0, 0, 0, 0, 3, 0, 0, 0, 137, 0, 0, 0, 3, 1, 115, 0, 6, 0, 0, 0, 82, 101, 108, 111, 97, 100, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0}
+
+func TestEncodeEnvironment(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeEnvironment(conn, "SetEnvironment", []string{"FOO=bar", "LANG=C.UTF-8"}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(setEnvironmentRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEncodeEnvironmentStream(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	msgEnc.StreamSize = 16
+	conn := &chunkWriter{}
+	err := msgEnc.EncodeEnvironment(conn, "SetEnvironment", []string{"FOO=bar", "LANG=C.UTF-8"}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(setEnvironmentRequest, conn.Bytes()); diff != "" {
+		t.Error(diff)
+	}
+	// The header is written at once, and the body is written in chunks.
+	if len(conn.chunks) < 3 {
+		t.Errorf("expected the message to be written in chunks got %d", len(conn.chunks))
+	}
+
+	// The encoder must return to the buffered mode.
+	msgEnc.StreamSize = 0
+	buf := &bytes.Buffer{}
+	if err = msgEnc.EncodeEnvironment(buf, "SetEnvironment", []string{"FOO=bar", "LANG=C.UTF-8"}, 3); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(setEnvironmentRequest, buf.Bytes()); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEncodeStreamWriteError(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	msgEnc.StreamSize = 16
+	conn := &chunkWriter{err: io.ErrClosedPipe}
+
+	err := msgEnc.EncodeEnvironment(conn, "SetEnvironment", []string{"FOO=bar"}, 3)
+	if !errors.Is(err, io.ErrClosedPipe) {
+		t.Fatalf("expected io.ErrClosedPipe got %v", err)
+	}
+	if len(conn.chunks) != 0 {
+		t.Errorf("expected no writes after the error got %d", len(conn.chunks))
+	}
+}
+
+// chunkWriter records every write separately.
+// If err is set, the writes fail.
+type chunkWriter struct {
+	chunks [][]byte
+	err    error
+}
+
+func (w *chunkWriter) Write(b []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	w.chunks = append(w.chunks, append([]byte(nil), b...))
+	return len(b), nil
+}
+
+// Bytes returns all the written bytes.
+func (w *chunkWriter) Bytes() []byte {
+	var b []byte
+	for _, c := range w.chunks {
+		b = append(b, c...)
+	}
+	return b
+}
+
+// setEnvironmentRequest is a D-Bus message to set
+// FOO=bar and LANG=C.UTF-8 environment variables of the service manager.
+var setEnvironmentRequest = []byte{108, 1, 0, 1, 33, 0, 0, 0, 3, 0, 0, 0, 160, 0, 0, 0, 3, 1, 115, 0, 14, 0, 0, 0, 83, 101, 116, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 2, 97, 115, 0, 29, 0, 0, 0, 7, 0, 0, 0, 70, 79, 79, 61, 98, 97, 114, 0, 12, 0, 0, 0, 76, 65, 78, 71, 61, 67, 46, 85, 84, 70, 45, 56, 0}
+
+func TestEncodeGetObjectProperty(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeGetObjectProperty(conn, "/org/freedesktop/systemd1", "org.freedesktop.systemd1.Manager", "Environment", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(environmentRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDecodeProperty(t *testing.T) {
+	conn := bytes.NewReader(environmentResponse)
+	msgDec := newMessageDecoder()
+
+	got, err := msgDec.DecodeProperty(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Variant{
+		Signature: "as",
+		Strings: []string{
+			"LANG=C.UTF-8",
+			"PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+// environmentRequest is a D-Bus message to request
+// the environment of the service manager.
+var environmentRequest = []byte{108, 1, 0, 1, 56, 0, 0, 0, 3, 0, 0, 0, 144, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 3, 1, 115, 0, 3, 0, 0, 0, 71, 101, 116, 0, 0, 0, 0, 0, 2, 1, 115, 0, 31, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 0, 8, 1, 103, 0, 2, 115, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 11, 0, 0, 0, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 0}
+
+// environmentResponse is a reply to environmentRequest.
+var environmentResponse = []byte{108, 2, 1, 1, 87, 0, 0, 0, 222, 8, 0, 0, 45, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 1, 118, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 2, 97, 115, 0, 79, 0, 0, 0, 12, 0, 0, 0, 76, 65, 78, 71, 61, 67, 46, 85, 84, 70, 45, 56, 0, 0, 0, 0, 54, 0, 0, 0, 80, 65, 84, 72, 61, 47, 117, 115, 114, 47, 108, 111, 99, 97, 108, 47, 115, 98, 105, 110, 58, 47, 117, 115, 114, 47, 108, 111, 99, 97, 108, 47, 98, 105, 110, 58, 47, 117, 115, 114, 47, 115, 98, 105, 110, 58, 47, 117, 115, 114, 47, 98, 105, 110, 0}
+
+// startUnitNoSuchUnitArgsResponse is an error reply to a request
+// to start an unknown unit "nope.service"
+// where the error message is followed by the unit name argument.
+var startUnitNoSuchUnitArgsResponse = []byte{108, 3, 1, 1, 53, 0, 0, 0, 217, 8, 0, 0, 93, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 4, 1, 115, 0, 35, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 78, 111, 83, 117, 99, 104, 85, 110, 105, 116, 0, 0, 0, 0, 0, 8, 1, 103, 0, 2, 115, 115, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 28, 0, 0, 0, 85, 110, 105, 116, 32, 110, 111, 112, 101, 46, 115, 101, 114, 118, 105, 99, 101, 32, 110, 111, 116, 32, 102, 111, 117, 110, 100, 46, 0, 0, 0, 0, 12, 0, 0, 0, 110, 111, 112, 101, 46, 115, 101, 114, 118, 105, 99, 101, 0}
+
+// managerPropertiesResponse is a reply to GetAll request
+// of org.freedesktop.systemd1.Manager properties
+// that contains a subset of the properties.
+var managerPropertiesResponse = []byte{108, 2, 1, 1, 17, 2, 0, 0, 223, 8, 0, 0, 53, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 5, 97, 123, 115, 118, 125, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 9, 2, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 86, 101, 114, 115, 105, 111, 110, 0, 1, 115, 0, 0, 14, 0, 0, 0, 50, 53, 53, 46, 52, 45, 49, 117, 98, 117, 110, 116, 117, 56, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 70, 101, 97, 116, 117, 114, 101, 115, 0, 1, 115, 0, 20, 0, 0, 0, 43, 80, 65, 77, 32, 43, 65, 85, 68, 73, 84, 32, 43, 83, 69, 76, 73, 78, 85, 88, 0, 0, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 86, 105, 114, 116, 117, 97, 108, 105, 122, 97, 116, 105, 111, 110, 0, 1, 115, 0, 0, 0, 3, 0, 0, 0, 107, 118, 109, 0, 12, 0, 0, 0, 65, 114, 99, 104, 105, 116, 101, 99, 116, 117, 114, 101, 0, 1, 115, 0, 6, 0, 0, 0, 120, 56, 54, 45, 54, 52, 0, 0, 7, 0, 0, 0, 84, 97, 105, 110, 116, 101, 100, 0, 1, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 70, 105, 114, 109, 119, 97, 114, 101, 84, 105, 109, 101, 115, 116, 97, 109, 112, 0, 1, 116, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 18, 0, 0, 0, 85, 115, 101, 114, 115, 112, 97, 99, 101, 84, 105, 109, 101, 115, 116, 97, 109, 112, 0, 1, 116, 0, 0, 0, 0, 0, 0, 0, 105, 249, 188, 175, 47, 21, 6, 0, 15, 0, 0, 0, 70, 105, 110, 105, 115, 104, 84, 105, 109, 101, 115, 116, 97, 109, 112, 0, 1, 116, 0, 0, 140, 42, 13, 176, 47, 21, 6, 0, 6, 0, 0, 0, 78, 78, 97, 109, 101, 115, 0, 1, 117, 0, 0, 0, 35, 1, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 78, 70, 97, 105, 108, 101, 100, 85, 110, 105, 116, 115, 0, 1, 117, 0, 1, 0, 0, 0, 5, 0, 0, 0, 78, 74, 111, 98, 115, 0, 1, 117, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 78, 73, 110, 115, 116, 97, 108, 108, 101, 100, 74, 111, 98, 115, 0, 1, 117, 0, 0, 0, 187, 0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 78, 70, 97, 105, 108, 101, 100, 74, 111, 98, 115, 0, 1, 117, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 80, 114, 111, 103, 114, 101, 115, 115, 0, 1, 100, 0, 0, 0, 0, 0, 0, 0, 240, 63, 11, 0, 0, 0, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 0, 2, 97, 115, 0, 17, 0, 0, 0, 12, 0, 0, 0, 76, 65, 78, 71, 61, 67, 46, 85, 84, 70, 45, 56, 0, 0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 83, 121, 115, 116, 101, 109, 83, 116, 97, 116, 101, 0, 1, 115, 0, 0, 8, 0, 0, 0, 100, 101, 103, 114, 97, 100, 101, 100, 0}
+
+func TestEncodeAddMatch(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeAddMatch(conn, "type='signal',sender='org.freedesktop.systemd1'", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(addMatchRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEncodeSubscribe(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeSubscribe(conn, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(subscribeRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDecodeSignal(t *testing.T) {
+	conn := io.MultiReader(
+		bytes.NewReader(pingResponse),
+		bytes.NewReader(nameAcquiredSignal),
+		bytes.NewReader(pingResponse),
+		bytes.NewReader(unitNewSignal),
+	)
+	msgDec := newMessageDecoder()
+	msgDec.SkipHeaderFields = false
+
+	want := []Signal{
+		{
+			Sender:    "org.freedesktop.DBus",
+			Path:      "/org/freedesktop/DBus",
+			Interface: "org.freedesktop.DBus",
+			Member:    "NameAcquired",
+			Signature: "s",
+			Args: []Variant{
+				{Signature: "s", S: ":1.100"},
+			},
+		},
+		{
+			Sender:    ":1.0",
+			Path:      "/org/freedesktop/systemd1",
+			Interface: "org.freedesktop.systemd1.Manager",
+			Member:    "UnitNew",
+			Signature: "so",
+			Args: []Variant{
+				{Signature: "s", S: "dbus.service"},
+				{Signature: "o", S: "/org/freedesktop/systemd1/unit/dbus_2eservice"},
+			},
+		},
+	}
+	for _, w := range want {
+		var s Signal
+		if err := msgDec.DecodeSignal(conn, &s); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(w, s); diff != "" {
+			t.Error(diff)
+		}
+	}
+
+	if _, err := conn.Read(make([]byte, 1)); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+// addMatchRequest is a D-Bus message to subscribe to systemd signals.
+var addMatchRequest = []byte{108, 1, 0, 1, 52, 0, 0, 0, 2, 0, 0, 0, 127, 0, 0, 0, 6, 1, 115, 0, 20, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 0, 0, 0, 0, 3, 1, 115, 0, 8, 0, 0, 0, 65, 100, 100, 77, 97, 116, 99, 104, 0, 0, 0, 0, 0, 0, 0, 0, 2, 1, 115, 0, 20, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 0, 0, 0, 0, 1, 1, 111, 0, 21, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 68, 66, 117, 115, 0, 0, 0, 8, 1, 103, 0, 1, 115, 0, 0, 47, 0, 0, 0, 116, 121, 112, 101, 61, 39, 115, 105, 103, 110, 97, 108, 39, 44, 115, 101, 110, 100, 101, 114, 61, 39, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 39, 0}
+
+// subscribeRequest is a D-Bus message to enable systemd signals.
+var subscribeRequest = []byte{108, 1, 0, 1, 0, 0, 0, 0, 3, 0, 0, 0, 145, 0, 0, 0, 3, 1, 115, 0, 9, 0, 0, 0, 83, 117, 98, 115, 99, 114, 105, 98, 101, 0, 0, 0, 0, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0}
+
+// unitNewSignal is UnitNew signal emitted by systemd when "dbus.service" is loaded.
+var unitNewSignal = []byte{108, 4, 1, 1, 70, 0, 0, 0, 226, 8, 0, 0, 125, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 3, 1, 115, 0, 7, 0, 0, 0, 85, 110, 105, 116, 78, 101, 119, 0, 8, 1, 103, 0, 2, 115, 111, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0}
+
+func TestSignalJobRemoved(t *testing.T) {
+	tt := map[string]struct {
+		in     []byte
+		want   JobRemoved
+		wantOK bool
+	}{
+		"done": {
+			in: jobRemovedSignal,
+			want: JobRemoved{
+				ID:     1292,
+				Path:   "/org/freedesktop/systemd1/job/1292",
+				Unit:   "dbus.service",
+				Result: "done",
+			},
+			wantOK: true,
+		},
+		"failed": {
+			in: otherJobRemovedSignal,
+			want: JobRemoved{
+				ID:     1291,
+				Path:   "/org/freedesktop/systemd1/job/1291",
+				Unit:   "ssh.service",
+				Result: "failed",
+			},
+			wantOK: true,
+		},
+		"other signal": {
+			in: unitNewSignal,
+		},
+	}
+
+	msgDec := newMessageDecoder()
+	msgDec.SkipHeaderFields = false
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			var s Signal
+			if err := msgDec.DecodeSignal(bytes.NewReader(tc.in), &s); err != nil {
+				t.Fatal(err)
+			}
+
+			got, ok := s.JobRemoved()
+			if ok != tc.wantOK {
+				t.Errorf("expected ok %t got %t", tc.wantOK, ok)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSignalReloading(t *testing.T) {
+	tt := map[string]struct {
+		in         []byte
+		wantActive bool
+		wantOK     bool
+	}{
+		"started":      {in: reloadingSignal, wantActive: true, wantOK: true},
+		"finished":     {in: reloadedSignal, wantOK: true},
+		"other signal": {in: jobRemovedSignal},
+	}
+
+	msgDec := newMessageDecoder()
+	msgDec.SkipHeaderFields = false
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			var s Signal
+			if err := msgDec.DecodeSignal(bytes.NewReader(tc.in), &s); err != nil {
+				t.Fatal(err)
+			}
+
+			active, ok := s.Reloading()
+			if ok != tc.wantOK {
+				t.Errorf("expected ok %t got %t", tc.wantOK, ok)
+			}
+			if active != tc.wantActive {
+				t.Errorf("expected active %t got %t", tc.wantActive, active)
+			}
+		})
+	}
+}
+
+func TestSignalPropertiesChanged(t *testing.T) {
+	const unitPath = "/org/freedesktop/systemd1/unit/dbus_2eservice"
+	tt := map[string]struct {
+		in     []byte
+		want   PropertiesChanged
+		wantOK bool
+	}{
+		"changed": {
+			in: activeStateChangedSignal,
+			want: PropertiesChanged{
+				Path:      unitPath,
+				Interface: "org.freedesktop.systemd1.Unit",
+				Changed: map[string]Variant{
+					"ActiveState": {Signature: "s", S: "activating"},
+				},
+			},
+			wantOK: true,
+		},
+		"invalidated": {
+			in: mainPIDInvalidatedSignal,
+			want: PropertiesChanged{
+				Path:        unitPath,
+				Interface:   "org.freedesktop.systemd1.Service",
+				Changed:     map[string]Variant{},
+				Invalidated: []string{"ExecMainStartTimestamp", "MainPID"},
+			},
+			wantOK: true,
+		},
+		"other signal": {in: jobRemovedSignal},
+	}
+
+	msgDec := newMessageDecoder()
+	msgDec.SkipHeaderFields = false
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			var s Signal
+			if err := msgDec.DecodeSignal(bytes.NewReader(tc.in), &s); err != nil {
+				t.Fatal(err)
+			}
+
+			got, ok, err := s.PropertiesChanged()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != tc.wantOK {
+				t.Errorf("expected ok %t got %t", tc.wantOK, ok)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+// jobRemovedSignal is JobRemoved signal emitted by systemd
+// when the job 1292 of dbus.service finished successfully.
+var jobRemovedSignal = []byte{108, 4, 1, 1, 73, 0, 0, 0, 7, 9, 0, 0, 141, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 3, 1, 115, 0, 10, 0, 0, 0, 74, 111, 98, 82, 101, 109, 111, 118, 101, 100, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 4, 117, 111, 115, 115, 0, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 12, 5, 0, 0, 34, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 106, 111, 98, 47, 49, 50, 57, 50, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 4, 0, 0, 0, 100, 111, 110, 101, 0}
+
+// otherJobRemovedSignal is JobRemoved signal
+// of the failed job 1291 of ssh.service.
+var otherJobRemovedSignal = []byte{108, 4, 1, 1, 71, 0, 0, 0, 6, 9, 0, 0, 141, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 3, 1, 115, 0, 10, 0, 0, 0, 74, 111, 98, 82, 101, 109, 111, 118, 101, 100, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 4, 117, 111, 115, 115, 0, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 11, 5, 0, 0, 34, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 106, 111, 98, 47, 49, 50, 57, 49, 0, 0, 11, 0, 0, 0, 115, 115, 104, 46, 115, 101, 114, 118, 105, 99, 101, 0, 6, 0, 0, 0, 102, 97, 105, 108, 101, 100, 0}
+
+func TestEncodeNRestarts(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeGetProperty(conn, "dbus.service", "org.freedesktop.systemd1.Service", "NRestarts", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(nRestartsRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDecodeUint32Property(t *testing.T) {
+	conn := bytes.NewReader(nRestartsResponse)
+	msgDec := newMessageDecoder()
+
+	got, err := msgDec.DecodeUint32Property(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want uint32 = 3
+	if want != got {
+		t.Errorf("expected %d got %d", want, got)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+func TestDecodeUint32PropertyError(t *testing.T) {
+	tt := map[string]struct {
+		in     []byte
+		errMsg string
+	}{
+		"unknown property": {
+			in:     mainPIDUnknownPropertyResponse,
+			errMsg: "Unknown interface org.freedesktop.systemd1.Service or property MainPID.",
+		},
+		"signature mismatch": {
+			in:     memoryAvailableResponse,
+			errMsg: "expected variant signature u, got t",
+		},
+	}
+
+	msgDec := newMessageDecoder()
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conn := bytes.NewReader(tc.in)
+
+			_, err := msgDec.DecodeUint32Property(conn)
+			if err == nil || tc.errMsg != err.Error() {
+				t.Fatalf("expected error %q got %q", tc.errMsg, err)
+			}
+
+			if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+				t.Errorf("conn has unread bytes")
+			}
+		})
+	}
+}
+
+// nRestartsRequest is a D-Bus message to request
+// the number of automatic restarts of "dbus.service".
+var nRestartsRequest = []byte{108, 1, 0, 1, 54, 0, 0, 0, 3, 0, 0, 0, 160, 0, 0, 0, 1, 1, 111, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 3, 1, 115, 0, 3, 0, 0, 0, 71, 101, 116, 0, 0, 0, 0, 0, 2, 1, 115, 0, 31, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 0, 8, 1, 103, 0, 2, 115, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 83, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 9, 0, 0, 0, 78, 82, 101, 115, 116, 97, 114, 116, 115, 0}
+
+// nRestartsResponse is a reply to nRestartsRequest
+// that contains 3 restarts.
+var nRestartsResponse = []byte{108, 2, 1, 1, 8, 0, 0, 0, 229, 8, 0, 0, 45, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 1, 118, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 1, 117, 0, 0, 3, 0, 0, 0}
+
+func TestDecodeEnableUnitFiles(t *testing.T) {
+	want := []UnitFileChange{
+		{
+			Type:        "symlink",
+			Filename:    "/etc/systemd/system/multi-user.target.wants/ssh.service",
+			Destination: "/lib/systemd/system/ssh.service",
+		},
+		{
+			Type:        "symlink",
+			Filename:    "/etc/systemd/system/sshd.service",
+			Destination: "/lib/systemd/system/ssh.service",
+		},
+	}
+	installInfo := true
+	conn := bytes.NewReader(encodeFakeReply(10, 3, ":1.100", fakeUnitFileChanges(&installInfo, want...)))
+	msgDec := newMessageDecoder()
+
+	gotInstallInfo, got, err := msgDec.DecodeEnableUnitFiles(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gotInstallInfo {
+		t.Error("expected install info")
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEncodeGetUnitFileState(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeGetUnitFileState(conn, "dbus.service", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(unitFileStateRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDecodeStringReply(t *testing.T) {
+	conn := bytes.NewReader(unitFileStateResponse)
+	msgDec := newMessageDecoder()
+
+	got, err := msgDec.DecodeString(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "static"
+	if want != got {
+		t.Errorf("expected %q got %q", want, got)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+func TestUnitFileStateIsEnabled(t *testing.T) {
+	tt := map[UnitFileState]bool{
+		UnitFileEnabled:        true,
+		UnitFileEnabledRuntime: true,
+		UnitFileAlias:          true,
+		UnitFileIndirect:       true,
+		UnitFileStatic:         false,
+		UnitFileDisabled:       false,
+		UnitFileLinked:         false,
+		UnitFileMasked:         false,
+		UnitFileGenerated:      false,
+		UnitFileTransient:      false,
+		UnitFileBad:            false,
+		"":                     false,
+	}
+
+	for state, want := range tt {
+		if got := state.IsEnabled(); want != got {
+			t.Errorf("%q: expected %t got %t", state, want, got)
+		}
+	}
+}
+
+func TestUnitFileStateOrigin(t *testing.T) {
+	tt := map[UnitFileState]struct {
+		generated bool
+		transient bool
+	}{
+		UnitFileGenerated: {generated: true},
+		UnitFileTransient: {transient: true},
+		UnitFileEnabled:   {},
+		UnitFileDisabled:  {},
+		UnitFileStatic:    {},
+		"":                {},
+	}
+
+	for state, want := range tt {
+		if got := state.IsGenerated(); want.generated != got {
+			t.Errorf("%q: expected generated %t got %t", state, want.generated, got)
+		}
+		if got := state.IsTransient(); want.transient != got {
+			t.Errorf("%q: expected transient %t got %t", state, want.transient, got)
+		}
+	}
+}
+
+// unitFileStateRequest is a D-Bus message to request
+// the unit file state of "dbus.service".
+var unitFileStateRequest = []byte{108, 1, 0, 1, 17, 0, 0, 0, 3, 0, 0, 0, 167, 0, 0, 0, 3, 1, 115, 0, 16, 0, 0, 0, 71, 101, 116, 85, 110, 105, 116, 70, 105, 108, 101, 83, 116, 97, 116, 101, 0, 0, 0, 0, 0, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 1, 115, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0}
+
+// unitFileStateResponse is a reply to unitFileStateRequest
+// that contains "static" state.
+var unitFileStateResponse = []byte{108, 2, 1, 1, 11, 0, 0, 0, 231, 8, 0, 0, 45, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 1, 115, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 6, 0, 0, 0, 115, 116, 97, 116, 105, 99, 0}
+
+func TestEncodeGetUnit(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeGetUnit(conn, "dbus.service", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(getUnitRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEncodeMainPIDAtPath(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeGetObjectProperty(conn, "/org/freedesktop/systemd1/unit/dbus_2eservice", "org.freedesktop.systemd1.Service", "MainPID", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The request is the same as the one with the path derived from the unit name.
+	got := conn.Bytes()
+	if diff := cmp.Diff(mainPIDRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+// getUnitRequest is a D-Bus message to request the object path of "dbus.service".
+var getUnitRequest = []byte{108, 1, 0, 1, 17, 0, 0, 0, 3, 0, 0, 0, 151, 0, 0, 0, 3, 1, 115, 0, 7, 0, 0, 0, 71, 101, 116, 85, 110, 105, 116, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 1, 115, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0}
+
+// getUnitResponse is a reply to getUnitRequest that contains the unit object path.
+var getUnitResponse = []byte{108, 2, 1, 1, 50, 0, 0, 0, 232, 8, 0, 0, 45, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 1, 111, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0}
+
+func TestEncodeDump(t *testing.T) {
+	tt := map[string][]byte{
+		"Dump":                 dumpRequest,
+		"DumpByFileDescriptor": dumpByFileDescriptorRequest,
+	}
+
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+
+	for member, want := range tt {
+		t.Run(member, func(t *testing.T) {
+			conn.Reset()
+			if err := msgEnc.EncodeDump(conn, member, 3); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(want, conn.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+// dumpRequest is a D-Bus message to request the manager state dump.
+var dumpRequest = []byte{108, 1, 0, 1, 0, 0, 0, 0, 3, 0, 0, 0, 137, 0, 0, 0, 3, 1, 115, 0, 4, 0, 0, 0, 68, 117, 109, 112, 0, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0}
+
+// dumpByFileDescriptorRequest is a D-Bus message to request
+// the manager state dump in a memfd.
+var dumpByFileDescriptorRequest = []byte{108, 1, 0, 1, 0, 0, 0, 0, 3, 0, 0, 0, 153, 0, 0, 0, 3, 1, 115, 0, 20, 0, 0, 0, 68, 117, 109, 112, 66, 121, 70, 105, 108, 101, 68, 101, 115, 99, 114, 105, 112, 116, 111, 114, 0, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0}
+
+func TestEncodeNFailedUnits(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeGetObjectProperty(conn, "/org/freedesktop/systemd1", "org.freedesktop.systemd1.Manager", "NFailedUnits", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(nFailedUnitsRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+// nFailedUnitsRequest is a D-Bus message to request
+// the number of failed units from the manager.
+var nFailedUnitsRequest = []byte{108, 1, 0, 1, 57, 0, 0, 0, 3, 0, 0, 0, 144, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 3, 1, 115, 0, 3, 0, 0, 0, 71, 101, 116, 0, 0, 0, 0, 0, 2, 1, 115, 0, 31, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 0, 8, 1, 103, 0, 2, 115, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 12, 0, 0, 0, 78, 70, 97, 105, 108, 101, 100, 85, 110, 105, 116, 115, 0}
+
+// nFailedUnitsResponse is a reply to nFailedUnitsRequest
+// that contains one failed unit.
+var nFailedUnitsResponse = []byte{108, 2, 1, 1, 8, 0, 0, 0, 234, 8, 0, 0, 45, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 1, 118, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 1, 117, 0, 0, 1, 0, 0, 0}
+
+func TestDecodeEachPropertyExotic(t *testing.T) {
+	conn := bytes.NewReader(exoticPropertiesResponse)
+	msgDec := newMessageDecoder()
+
+	got := make(map[string]Variant)
+	err := msgDec.DecodeEachProperty(conn, func(name string, v Variant) bool {
+		got[name] = v
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The properties after the container ones are decoded as usual.
+	want := map[string]Variant{
+		"Id":                     {Signature: "s", S: "dbus.service"},
+		"NUMAPolicy":             {Signature: "i", I: -1},
+		"ExecMainStartTimestamp": {Signature: "t", U: 1712144418339152},
+		"ConditionResult":        {Signature: "b", U: 1},
+		"MainPID":                {Signature: "u", U: 1241},
+		"CPUAffinity": {Signature: "ay", Raw: &RawVariant{
+			Signature: "ay",
+			Bytes:     []byte{15},
+		}},
+		"StandardInputData": {Signature: "ay", Raw: &RawVariant{
+			Signature: "ay",
+		}},
+		"IPAddressAllow": {Signature: "a(iayu)", Raw: &RawVariant{
+			Signature: "a(iayu)",
+			Bytes:     []byte{2, 0, 0, 0, 4, 0, 0, 0, 127, 0, 0, 1, 8, 0, 0, 0},
+		}},
+		"LogExtraFields": {Signature: "aay", Raw: &RawVariant{
+			Signature: "aay",
+			Bytes:     []byte{7, 0, 0, 0, 70, 79, 79, 61, 98, 97, 114, 0, 3, 0, 0, 0, 88, 61, 49},
+			Offset:    4,
+		}},
+		"Job": {Signature: "(uo)", Raw: &RawVariant{
+			Signature: "(uo)",
+			Bytes:     []byte{0, 0, 0, 0, 1, 0, 0, 0, 47, 0},
+		}},
+	}
+	// The remaining container properties are checked by their signature.
+	sigs := map[string]string{
+		"IOReadBandwidthMax": "a(st)",
+		"ExtensionImages":    "a(sba(ss))",
+		"OpenFile":           "a(sst)",
+	}
+	for name, sig := range sigs {
+		v := got[name]
+		if v.Raw == nil || v.Raw.Signature != sig || len(v.Raw.Bytes) == 0 {
+			t.Errorf("unexpected %s %+v", name, v)
+		}
+		delete(got, name)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+// exoticPropertiesResponse is a reply to GetAll method
+// that contains properties of nested container types
+// which are returned as RawVariant.
+var exoticPropertiesResponse = []byte{108, 2, 1, 1, 52, 2, 0, 0, 235, 8, 0, 0, 53, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 5, 97, 123, 115, 118, 125, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 44, 2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 73, 100, 0, 1, 115, 0, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 18, 0, 0, 0, 73, 79, 82, 101, 97, 100, 66, 97, 110, 100, 119, 105, 100, 116, 104, 77, 97, 120, 0, 5, 97, 40, 115, 116, 41, 0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 47, 100, 101, 118, 47, 115, 100, 97, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 14, 0, 0, 0, 76, 111, 103, 69, 120, 116, 114, 97, 70, 105, 101, 108, 100, 115, 0, 3, 97, 97, 121, 0, 19, 0, 0, 0, 7, 0, 0, 0, 70, 79, 79, 61, 98, 97, 114, 0, 3, 0, 0, 0, 88, 61, 49, 0, 11, 0, 0, 0, 67, 80, 85, 65, 102, 102, 105, 110, 105, 116, 121, 0, 2, 97, 121, 0, 1, 0, 0, 0, 15, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0, 0, 83, 116, 97, 110, 100, 97, 114, 100, 73, 110, 112, 117, 116, 68, 97, 116, 97, 0, 2, 97, 121, 0, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 73, 80, 65, 100, 100, 114, 101, 115, 115, 65, 108, 108, 111, 119, 0, 7, 97, 40, 105, 97, 121, 117, 41, 0, 16, 0, 0, 0, 2, 0, 0, 0, 4, 0, 0, 0, 127, 0, 0, 1, 8, 0, 0, 0, 15, 0, 0, 0, 69, 120, 116, 101, 110, 115, 105, 111, 110, 73, 109, 97, 103, 101, 115, 0, 10, 97, 40, 115, 98, 97, 40, 115, 115, 41, 41, 0, 43, 0, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 47, 105, 109, 103, 46, 114, 97, 119, 0, 0, 0, 0, 0, 0, 0, 0, 19, 0, 0, 0, 4, 0, 0, 0, 114, 111, 111, 116, 0, 0, 0, 0, 2, 0, 0, 0, 114, 111, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 79, 112, 101, 110, 70, 105, 108, 101, 0, 6, 97, 40, 115, 115, 116, 41, 0, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 6, 0, 0, 0, 47, 101, 116, 99, 47, 97, 0, 0, 1, 0, 0, 0, 97, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 78, 85, 77, 65, 80, 111, 108, 105, 99, 121, 0, 1, 105, 0, 0, 0, 255, 255, 255, 255, 22, 0, 0, 0, 69, 120, 101, 99, 77, 97, 105, 110, 83, 116, 97, 114, 116, 84, 105, 109, 101, 115, 116, 97, 109, 112, 0, 1, 116, 0, 0, 0, 80, 249, 219, 175, 47, 21, 6, 0, 15, 0, 0, 0, 67, 111, 110, 100, 105, 116, 105, 111, 110, 82, 101, 115, 117, 108, 116, 0, 1, 98, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 74, 111, 98, 0, 4, 40, 117, 111, 41, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 77, 97, 105, 110, 80, 73, 68, 0, 1, 117, 0, 0, 217, 4, 0, 0}
+
+func TestEncodeIntrospect(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeIntrospect(conn, "/org/freedesktop/systemd1/unit/dbus_2eservice", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(introspectRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+// introspectRequest is a D-Bus message to request
+// the introspection XML of "dbus.service" object.
+var introspectRequest = []byte{108, 1, 0, 1, 0, 0, 0, 0, 3, 0, 0, 0, 161, 0, 0, 0, 3, 1, 115, 0, 10, 0, 0, 0, 73, 110, 116, 114, 111, 115, 112, 101, 99, 116, 0, 0, 0, 0, 0, 0, 2, 1, 115, 0, 35, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 73, 110, 116, 114, 111, 115, 112, 101, 99, 116, 97, 98, 108, 101, 0, 0, 0, 0, 0, 1, 1, 111, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0}
+
+func TestEncodeGetUnitProcesses(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	err := msgEnc.EncodeGetUnitProcesses(conn, "dbus.service", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(getUnitProcessesRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDecodeUnitProcesses(t *testing.T) {
+	tt := map[string]struct {
+		in   []byte
+		want []UnitProcess
+	}{
+		"processes": {
+			in: getUnitProcessesResponse,
+			want: []UnitProcess{
+				{
+					CgroupPath: "/system.slice/dbus.service",
+					PID:        1241,
+					Command:    "/usr/bin/dbus-daemon --system --address=systemd: --nofork --nopidfile --systemd-activation --syslog-only",
+				},
+				{
+					CgroupPath: "/system.slice/dbus.service/helper",
+					PID:        1250,
+					Command:    "[kworker/0:1]",
+				},
+			},
+		},
+		"empty": {
+			in: getUnitProcessesEmptyResponse,
+		},
+	}
+
+	msgDec := newMessageDecoder()
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conn := bytes.NewReader(tc.in)
+			got, err := msgDec.DecodeUnitProcesses(conn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error(diff)
+			}
+
+			if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+				t.Errorf("conn has unread bytes")
+			}
+		})
+	}
+}
+
+func TestDecodeUnitProcessesUnknownMethod(t *testing.T) {
+	msgDec := newMessageDecoder()
+	_, err := msgDec.DecodeUnitProcesses(bytes.NewReader(getUnitProcessesUnknownMethodResponse))
+	if !errors.Is(err, ErrUnknownMethod) {
+		t.Fatalf("expected ErrUnknownMethod got %v", err)
+	}
+}
+
+// getUnitProcessesRequest is a D-Bus message to request
+// the processes of "dbus.service" unit.
+var getUnitProcessesRequest = []byte{108, 1, 0, 1, 17, 0, 0, 0, 3, 0, 0, 0, 167, 0, 0, 0, 3, 1, 115, 0, 16, 0, 0, 0, 71, 101, 116, 85, 110, 105, 116, 80, 114, 111, 99, 101, 115, 115, 101, 115, 0, 0, 0, 0, 0, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 1, 115, 0, 0, 12, 0, 0, 0, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0}
+
+// getUnitProcessesResponse is a reply to getUnitProcessesRequest
+// with the daemon's process and a kernel thread in the child cgroup.
+var getUnitProcessesResponse = []byte{108, 2, 1, 1, 222, 0, 0, 0, 242, 8, 0, 0, 53, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 6, 97, 40, 115, 117, 115, 41, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 214, 0, 0, 0, 0, 0, 0, 0, 26, 0, 0, 0, 47, 115, 121, 115, 116, 101, 109, 46, 115, 108, 105, 99, 101, 47, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 217, 4, 0, 0, 104, 0, 0, 0, 47, 117, 115, 114, 47, 98, 105, 110, 47, 100, 98, 117, 115, 45, 100, 97, 101, 109, 111, 110, 32, 45, 45, 115, 121, 115, 116, 101, 109, 32, 45, 45, 97, 100, 100, 114, 101, 115, 115, 61, 115, 121, 115, 116, 101, 109, 100, 58, 32, 45, 45, 110, 111, 102, 111, 114, 107, 32, 45, 45, 110, 111, 112, 105, 100, 102, 105, 108, 101, 32, 45, 45, 115, 121, 115, 116, 101, 109, 100, 45, 97, 99, 116, 105, 118, 97, 116, 105, 111, 110, 32, 45, 45, 115, 121, 115, 108, 111, 103, 45, 111, 110, 108, 121, 0, 0, 0, 0, 0, 0, 0, 0, 33, 0, 0, 0, 47, 115, 121, 115, 116, 101, 109, 46, 115, 108, 105, 99, 101, 47, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 47, 104, 101, 108, 112, 101, 114, 0, 0, 0, 226, 4, 0, 0, 13, 0, 0, 0, 91, 107, 119, 111, 114, 107, 101, 114, 47, 48, 58, 49, 93, 0}
+
+// getUnitProcessesEmptyResponse is a reply to getUnitProcessesRequest
+// when the unit has no processes.
+var getUnitProcessesEmptyResponse = []byte{108, 2, 1, 1, 8, 0, 0, 0, 243, 8, 0, 0, 53, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 6, 97, 40, 115, 117, 115, 41, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+
+// getUnitProcessesUnknownMethodResponse is an error reply to getUnitProcessesRequest
+// from systemd older than v238.
+var getUnitProcessesUnknownMethodResponse = []byte{108, 3, 1, 1, 83, 0, 0, 0, 244, 8, 0, 0, 101, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 4, 1, 115, 0, 40, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 69, 114, 114, 111, 114, 46, 85, 110, 107, 110, 111, 119, 110, 77, 101, 116, 104, 111, 100, 0, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 1, 115, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 78, 0, 0, 0, 85, 110, 107, 110, 111, 119, 110, 32, 109, 101, 116, 104, 111, 100, 32, 71, 101, 116, 85, 110, 105, 116, 80, 114, 111, 99, 101, 115, 115, 101, 115, 32, 111, 114, 32, 105, 110, 116, 101, 114, 102, 97, 99, 101, 32, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 46, 0}
+
+// controlGroupResponse is a reply to Get method
+// for ControlGroup property of "dbus.service" unit.
+var controlGroupResponse = []byte{108, 2, 1, 1, 35, 0, 0, 0, 245, 8, 0, 0, 45, 0, 0, 0, 5, 1, 117, 0, 4, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 1, 118, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 1, 115, 0, 0, 26, 0, 0, 0, 47, 115, 121, 115, 116, 101, 109, 46, 115, 108, 105, 99, 101, 47, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0}
+
+func TestEncodeListUnitFiles(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	conn := &bytes.Buffer{}
+	if err := msgEnc.EncodeListUnitFiles(conn, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	got := conn.Bytes()
+	if diff := cmp.Diff(listUnitFilesRequest, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDecodeListUnitFiles(t *testing.T) {
+	conn := bytes.NewReader(listUnitFilesResponse)
+	msgDec := newMessageDecoder()
+
+	var got []UnitFile
+	err := msgDec.DecodeListUnitFiles(conn, func(uf *UnitFile) {
+		got = append(got, *uf)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []UnitFile{
+		{Path: "/lib/systemd/system/ssh.service", State: "enabled"},
+		{Path: "/etc/systemd/system/getty.target.wants/getty@tty1.service", State: "enabled"},
+		{Path: "/lib/systemd/system/dbus.service", State: "static"},
+		{Path: "/lib/systemd/system/apt-daily.timer", State: "disabled"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err = conn.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("conn has unread bytes")
+	}
+}
+
+// listUnitFilesRequest is a D-Bus message to request the unit files.
+var listUnitFilesRequest = []byte{108, 1, 0, 1, 0, 0, 0, 0, 3, 0, 0, 0, 145, 0, 0, 0, 3, 1, 115, 0, 13, 0, 0, 0, 76, 105, 115, 116, 85, 110, 105, 116, 70, 105, 108, 101, 115, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 6, 1, 115, 0, 24, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 0}
+
+// listUnitFilesResponse is a reply to ListUnitFiles method
+// with four unit files in the order systemd lists them (unsorted).
+var listUnitFilesResponse = []byte{108, 2, 1, 1, 245, 0, 0, 0, 250, 5, 0, 0, 53, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 5, 97, 40, 115, 115, 41, 0, 0, 0, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 237, 0, 0, 0, 0, 0, 0, 0, 31, 0, 0, 0, 47, 108, 105, 98, 47, 115, 121, 115, 116, 101, 109, 100, 47, 115, 121, 115, 116, 101, 109, 47, 115, 115, 104, 46, 115, 101, 114, 118, 105, 99, 101, 0, 7, 0, 0, 0, 101, 110, 97, 98, 108, 101, 100, 0, 57, 0, 0, 0, 47, 101, 116, 99, 47, 115, 121, 115, 116, 101, 109, 100, 47, 115, 121, 115, 116, 101, 109, 47, 103, 101, 116, 116, 121, 46, 116, 97, 114, 103, 101, 116, 46, 119, 97, 110, 116, 115, 47, 103, 101, 116, 116, 121, 64, 116, 116, 121, 49, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 7, 0, 0, 0, 101, 110, 97, 98, 108, 101, 100, 0, 0, 0, 0, 0, 32, 0, 0, 0, 47, 108, 105, 98, 47, 115, 121, 115, 116, 101, 109, 100, 47, 115, 121, 115, 116, 101, 109, 47, 100, 98, 117, 115, 46, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 6, 0, 0, 0, 115, 116, 97, 116, 105, 99, 0, 0, 0, 0, 0, 0, 35, 0, 0, 0, 47, 108, 105, 98, 47, 115, 121, 115, 116, 101, 109, 100, 47, 115, 121, 115, 116, 101, 109, 47, 97, 112, 116, 45, 100, 97, 105, 108, 121, 46, 116, 105, 109, 101, 114, 0, 8, 0, 0, 0, 100, 105, 115, 97, 98, 108, 101, 100, 0}
+
+// reloadingSignal is Reloading signal emitted by systemd
+// when it started reloading its configuration.
+var reloadingSignal = []byte{108, 4, 1, 1, 4, 0, 0, 0, 16, 9, 0, 0, 133, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 3, 1, 115, 0, 9, 0, 0, 0, 82, 101, 108, 111, 97, 100, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 1, 98, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 1, 0, 0, 0}
+
+// reloadedSignal is Reloading signal emitted by systemd
+// once the reload is finished.
+var reloadedSignal = []byte{108, 4, 1, 1, 4, 0, 0, 0, 17, 9, 0, 0, 133, 0, 0, 0, 1, 1, 111, 0, 25, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 0, 0, 0, 0, 0, 0, 0, 2, 1, 115, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 77, 97, 110, 97, 103, 101, 114, 0, 0, 0, 0, 0, 0, 0, 0, 3, 1, 115, 0, 9, 0, 0, 0, 82, 101, 108, 111, 97, 100, 105, 110, 103, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 1, 98, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 0, 0, 0, 0}
@@ -0,0 +1,206 @@
+package systemd
+
+import "fmt"
+
+// maxNameLen is the maximum length of a bus name, interface, or member.
+const maxNameLen = 255
+
+// ValidateObjectPath checks that the object path is valid
+// according to the D-Bus specification, e.g.,
+// "/org/freedesktop/systemd1/unit/dbus_2eservice".
+// The path must begin with a slash and consist of elements
+// separated by slashes, and each element must be non-empty
+// and contain only the ASCII characters "[A-Z][a-z][0-9]_".
+// A trailing slash isn't allowed unless the path is the root path "/".
+//
+// The message bus disconnects a client which sends an invalid object path,
+// so the Client methods which accept an object path validate it beforehand.
+func ValidateObjectPath(path string) error {
+	if path == "" || path[0] != '/' {
+		return fmt.Errorf("object path must begin with a slash: %q", path)
+	}
+	if path == "/" {
+		return nil
+	}
+
+	elemLen := 0
+	for i := 1; i < len(path); i++ {
+		c := path[i]
+		switch {
+		case c == '/':
+			if elemLen == 0 {
+				return fmt.Errorf("object path has an empty element: %q", path)
+			}
+			elemLen = 0
+		case isAlnum(c) || c == '_':
+			elemLen++
+		default:
+			return fmt.Errorf("object path has an invalid character %q: %q", c, path)
+		}
+	}
+	if elemLen == 0 {
+		return fmt.Errorf("object path must not end with a slash: %q", path)
+	}
+
+	return nil
+}
+
+// ValidateBusName checks that the bus name is valid
+// according to the D-Bus specification.
+// It's either a unique connection name such as ":1.47",
+// or a well-known name such as "org.freedesktop.login1".
+// The name must have at least two non-empty elements separated by dots,
+// and each element must contain only the ASCII characters "[A-Z][a-z][0-9]_-".
+// The elements of a well-known name must not begin with a digit.
+// The name must not exceed 255 characters.
+func ValidateBusName(name string) error {
+	if name == "" {
+		return fmt.Errorf("bus name must not be empty")
+	}
+	if len(name) > maxNameLen {
+		return fmt.Errorf("bus name exceeded the maximum length: %d/%d bytes", len(name), maxNameLen)
+	}
+
+	s := name
+	isUnique := s[0] == ':'
+	if isUnique {
+		s = s[1:]
+	}
+
+	var (
+		elemLen  int
+		elemsNum = 1
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '.':
+			if elemLen == 0 {
+				return fmt.Errorf("bus name has an empty element: %q", name)
+			}
+			elemLen = 0
+			elemsNum++
+		case c >= '0' && c <= '9':
+			if elemLen == 0 && !isUnique {
+				return fmt.Errorf("bus name element must not begin with a digit: %q", name)
+			}
+			elemLen++
+		case isAlnum(c) || c == '_' || c == '-':
+			elemLen++
+		default:
+			return fmt.Errorf("bus name has an invalid character %q: %q", c, name)
+		}
+	}
+	if elemLen == 0 {
+		return fmt.Errorf("bus name has an empty element: %q", name)
+	}
+	if elemsNum < 2 {
+		return fmt.Errorf("bus name must have at least two elements: %q", name)
+	}
+
+	return nil
+}
+
+// ValidateInterfaceName checks that the interface name is valid
+// according to the D-Bus specification, e.g., "org.freedesktop.login1.Manager".
+// The name must have at least two non-empty elements separated by dots,
+// and each element must contain only the ASCII characters "[A-Z][a-z][0-9]_"
+// and must not begin with a digit.
+// The name must not exceed 255 characters.
+func ValidateInterfaceName(name string) error {
+	if name == "" {
+		return fmt.Errorf("interface name must not be empty")
+	}
+	if len(name) > maxNameLen {
+		return fmt.Errorf("interface name exceeded the maximum length: %d/%d bytes", len(name), maxNameLen)
+	}
+
+	var (
+		elemLen  int
+		elemsNum = 1
+	)
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '.':
+			if elemLen == 0 {
+				return fmt.Errorf("interface name has an empty element: %q", name)
+			}
+			elemLen = 0
+			elemsNum++
+		case c >= '0' && c <= '9':
+			if elemLen == 0 {
+				return fmt.Errorf("interface name element must not begin with a digit: %q", name)
+			}
+			elemLen++
+		case isAlnum(c) || c == '_':
+			elemLen++
+		default:
+			return fmt.Errorf("interface name has an invalid character %q: %q", c, name)
+		}
+	}
+	if elemLen == 0 {
+		return fmt.Errorf("interface name has an empty element: %q", name)
+	}
+	if elemsNum < 2 {
+		return fmt.Errorf("interface name must have at least two elements: %q", name)
+	}
+
+	return nil
+}
+
+// validateMemberName checks that the method name is valid
+// according to the D-Bus specification, e.g., "ListSessions".
+// It must be non-empty, contain only the ASCII characters "[A-Z][a-z][0-9]_",
+// must not begin with a digit, and must not exceed 255 characters.
+func validateMemberName(name string) error {
+	if name == "" {
+		return fmt.Errorf("member name must not be empty")
+	}
+	if len(name) > maxNameLen {
+		return fmt.Errorf("member name exceeded the maximum length: %d/%d bytes", len(name), maxNameLen)
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		return fmt.Errorf("member name must not begin with a digit: %q", name)
+	}
+	for i := 0; i < len(name); i++ {
+		if c := name[i]; !isAlnum(c) && c != '_' {
+			return fmt.Errorf("member name has an invalid character %q: %q", c, name)
+		}
+	}
+	return nil
+}
+
+// validateUniqueName checks that the name is a valid unique connection name
+// which the message bus assigns to a connection, e.g., ":1.47",
+// see ValidateBusName.
+func validateUniqueName(name string) error {
+	if name == "" {
+		return fmt.Errorf("unique connection name must not be empty")
+	}
+	if name[0] != ':' {
+		return fmt.Errorf("unique connection name must begin with a colon: %q", name)
+	}
+	return ValidateBusName(name)
+}
+
+// isEnvName reports whether name is a valid environment variable name
+// which systemd accepts, i.e., it's non-empty,
+// contains only the ASCII characters "[A-Z][a-z][0-9]_",
+// and doesn't begin with a digit.
+func isEnvName(name string) bool {
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if c := name[i]; !isAlnum(c) && c != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// isAlnum reports whether c is an ASCII letter or digit.
+func isAlnum(c byte) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,163 @@
+package systemd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateObjectPath(t *testing.T) {
+	tt := map[string]string{
+		"/":                         "",
+		"/org/freedesktop/systemd1": "",
+		"/org/freedesktop/systemd1/unit/dbus_2eservice": "",
+		"/org/freedesktop/login1/session/_32":           "",
+		"":                                              `object path must begin with a slash: ""`,
+		"org/freedesktop":                               `object path must begin with a slash: "org/freedesktop"`,
+		"/org/freedesktop/":                             `object path must not end with a slash: "/org/freedesktop/"`,
+		"/org//freedesktop":                             `object path has an empty element: "/org//freedesktop"`,
+		"//":                                            `object path has an empty element: "//"`,
+		"/org/freedesktop/dbus.service":                 `object path has an invalid character '.': "/org/freedesktop/dbus.service"`,
+		"/org/free-desktop":                             `object path has an invalid character '-': "/org/free-desktop"`,
+	}
+
+	for path, want := range tt {
+		t.Run(path, func(t *testing.T) {
+			err := ValidateObjectPath(path)
+			if want == "" && err != nil {
+				t.Fatalf("expected no error got %q", err)
+			}
+			if want != "" && (err == nil || err.Error() != want) {
+				t.Fatalf("expected error %q got %q", want, err)
+			}
+		})
+	}
+}
+
+func TestValidateBusName(t *testing.T) {
+	tt := map[string]string{
+		"org.freedesktop.systemd1":        "",
+		"org.freedesktop.login1":          "",
+		"org.freedesktop.NetworkManager":  "",
+		"com.example.my-service_2":        "",
+		":1.47":                           "",
+		":1.0":                            "",
+		"":                                "bus name must not be empty",
+		"systemd":                         `bus name must have at least two elements: "systemd"`,
+		":1":                              `bus name must have at least two elements: ":1"`,
+		".org.freedesktop":                `bus name has an empty element: ".org.freedesktop"`,
+		"org..freedesktop":                `bus name has an empty element: "org..freedesktop"`,
+		"org.freedesktop.":                `bus name has an empty element: "org.freedesktop."`,
+		":":                               `bus name has an empty element: ":"`,
+		"org.1freedesktop":                `bus name element must not begin with a digit: "org.1freedesktop"`,
+		"org.free/desktop":                `bus name has an invalid character '/': "org.free/desktop"`,
+		"org." + strings.Repeat("a", 252): "bus name exceeded the maximum length: 256/255 bytes",
+	}
+
+	for name, want := range tt {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateBusName(name)
+			if want == "" && err != nil {
+				t.Fatalf("expected no error got %q", err)
+			}
+			if want != "" && (err == nil || err.Error() != want) {
+				t.Fatalf("expected error %q got %q", want, err)
+			}
+		})
+	}
+}
+
+func TestValidateInterfaceName(t *testing.T) {
+	tt := map[string]string{
+		"org.freedesktop.login1.Manager":  "",
+		"org.freedesktop.DBus.Properties": "",
+		"com.example.my_service2":         "",
+		"":                                "interface name must not be empty",
+		"Manager":                         `interface name must have at least two elements: "Manager"`,
+		"org..freedesktop":                `interface name has an empty element: "org..freedesktop"`,
+		"org.freedesktop.":                `interface name has an empty element: "org.freedesktop."`,
+		"org.1freedesktop":                `interface name element must not begin with a digit: "org.1freedesktop"`,
+		"org.free-desktop":                `interface name has an invalid character '-': "org.free-desktop"`,
+		":1.47":                           `interface name has an invalid character ':': ":1.47"`,
+		"org." + strings.Repeat("a", 252): "interface name exceeded the maximum length: 256/255 bytes",
+	}
+
+	for name, want := range tt {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateInterfaceName(name)
+			if want == "" && err != nil {
+				t.Fatalf("expected no error got %q", err)
+			}
+			if want != "" && (err == nil || err.Error() != want) {
+				t.Fatalf("expected error %q got %q", want, err)
+			}
+		})
+	}
+}
+
+func TestValidateMemberName(t *testing.T) {
+	tt := map[string]string{
+		"ListSessions":           "",
+		"Get_2":                  "",
+		"":                       "member name must not be empty",
+		"2Get":                   `member name must not begin with a digit: "2Get"`,
+		"Lock.Session":           `member name has an invalid character '.': "Lock.Session"`,
+		strings.Repeat("a", 256): "member name exceeded the maximum length: 256/255 bytes",
+	}
+
+	for name, want := range tt {
+		t.Run(name, func(t *testing.T) {
+			err := validateMemberName(name)
+			if want == "" && err != nil {
+				t.Fatalf("expected no error got %q", err)
+			}
+			if want != "" && (err == nil || err.Error() != want) {
+				t.Fatalf("expected error %q got %q", want, err)
+			}
+		})
+	}
+}
+
+func TestValidateUniqueName(t *testing.T) {
+	tt := map[string]string{
+		":1.47":                "",
+		":1.2048":              "",
+		"":                     "unique connection name must not be empty",
+		"org.freedesktop.DBus": `unique connection name must begin with a colon: "org.freedesktop.DBus"`,
+		":1.":                  `bus name has an empty element: ":1."`,
+	}
+
+	for name, want := range tt {
+		t.Run(name, func(t *testing.T) {
+			err := validateUniqueName(name)
+			if want == "" && err != nil {
+				t.Fatalf("expected no error got %q", err)
+			}
+			if want != "" && (err == nil || err.Error() != want) {
+				t.Fatalf("expected error %q got %q", want, err)
+			}
+		})
+	}
+}
+
+func TestIsEnvName(t *testing.T) {
+	tt := map[string]bool{
+		"FOO":      true,
+		"LANG":     true,
+		"_private": true,
+		"GO111MOD": true,
+		"":         false,
+		"1FOO":     false,
+		"FOO-BAR":  false,
+		"FOO BAR":  false,
+		"FOO=bar":  false,
+		"ÜBER":     false,
+	}
+
+	for name, want := range tt {
+		t.Run(name, func(t *testing.T) {
+			if got := isEnvName(name); got != want {
+				t.Errorf("expected %t got %t", want, got)
+			}
+		})
+	}
+}
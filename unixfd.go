@@ -0,0 +1,95 @@
+//go:build unix
+
+package systemd
+
+import (
+	"fmt"
+	"net"
+	"syscall"
+)
+
+// writeMessageWithFDs writes the encoded message msg to conn
+// attaching the Unix file descriptors fds as SCM_RIGHTS ancillary data.
+// The file descriptors are sent along with the first chunk of the message,
+// the rest of the message is written as usual if sendmsg was partial.
+func writeMessageWithFDs(conn *net.UnixConn, msg []byte, fds []int) error {
+	n, _, err := conn.WriteMsgUnix(msg, syscall.UnixRights(fds...), nil)
+	if err != nil {
+		return err
+	}
+
+	if n < len(msg) {
+		if _, err = conn.Write(msg[n:]); err != nil {
+			return fmt.Errorf("write the rest of message: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// maxUnixFDs is the maximum number of file descriptors
+// which can be received along with a single read.
+// The D-Bus reference implementation limits the number of
+// Unix file descriptors per message to 16.
+const maxUnixFDs = 16
+
+func newUnixFDReader(conn *net.UnixConn) *unixFDReader {
+	return &unixFDReader{
+		conn: conn,
+		oob:  make([]byte, syscall.CmsgSpace(maxUnixFDs*4)),
+	}
+}
+
+// unixFDReader reads from a Unix domain socket
+// and collects the file descriptors received as SCM_RIGHTS ancillary data.
+// The collected file descriptors are surfaced to a decoder
+// which maps UNIX_FD indices found in a message body to them.
+type unixFDReader struct {
+	conn *net.UnixConn
+	// oob is a buffer for the ancillary data.
+	oob []byte
+	// FDs are the received file descriptors
+	// in the order they were sent by the peer.
+	FDs []int
+}
+
+// Read reads data from the connection
+// and saves the accompanying file descriptors if there are any.
+func (r *unixFDReader) Read(b []byte) (int, error) {
+	n, oobn, _, _, err := r.conn.ReadMsgUnix(b, r.oob)
+	if oobn == 0 {
+		return n, err
+	}
+
+	cmsgs, perr := syscall.ParseSocketControlMessage(r.oob[:oobn])
+	if perr != nil {
+		return n, fmt.Errorf("parse socket control message: %w", perr)
+	}
+	for i := range cmsgs {
+		fds, perr := syscall.ParseUnixRights(&cmsgs[i])
+		if perr != nil {
+			return n, fmt.Errorf("parse unix rights: %w", perr)
+		}
+		r.FDs = append(r.FDs, fds...)
+	}
+
+	return n, err
+}
+
+// Reset closes the received file descriptors
+// and starts reading from conn.
+func (r *unixFDReader) Reset(conn *net.UnixConn) {
+	r.CloseFDs()
+	r.conn = conn
+}
+
+// CloseFDs closes the received file descriptors
+// that weren't claimed by a decoder (see decoder.UnixFD).
+func (r *unixFDReader) CloseFDs() {
+	for _, fd := range r.FDs {
+		if fd >= 0 {
+			syscall.Close(fd)
+		}
+	}
+	r.FDs = r.FDs[:0]
+}
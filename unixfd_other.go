@@ -0,0 +1,37 @@
+//go:build !unix
+
+package systemd
+
+import (
+	"fmt"
+	"net"
+)
+
+// writeMessageWithFDs is not supported on this platform.
+func writeMessageWithFDs(conn *net.UnixConn, msg []byte, fds []int) error {
+	return fmt.Errorf("unix fd passing is not supported")
+}
+
+func newUnixFDReader(conn *net.UnixConn) *unixFDReader {
+	return &unixFDReader{conn: conn}
+}
+
+// unixFDReader reads from a Unix domain socket.
+// File descriptors can't be received on this platform.
+type unixFDReader struct {
+	conn *net.UnixConn
+	FDs  []int
+}
+
+// Read reads data from the connection.
+func (r *unixFDReader) Read(b []byte) (int, error) {
+	return r.conn.Read(b)
+}
+
+// Reset starts reading from conn.
+func (r *unixFDReader) Reset(conn *net.UnixConn) {
+	r.conn = conn
+}
+
+// CloseFDs does nothing since file descriptors can't be received.
+func (r *unixFDReader) CloseFDs() {}
@@ -0,0 +1,239 @@
+//go:build unix
+
+package systemd
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// unixConnPair returns a pair of connected Unix domain sockets.
+func unixConnPair(t *testing.T) (*net.UnixConn, *net.UnixConn) {
+	t.Helper()
+
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conns := make([]*net.UnixConn, len(fds))
+	for i, fd := range fds {
+		f := os.NewFile(uintptr(fd), "")
+		c, err := net.FileConn(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		conns[i] = c.(*net.UnixConn)
+		t.Cleanup(func() { c.Close() })
+	}
+
+	return conns[0], conns[1]
+}
+
+// readMessageWithFDs reads a message of msgLen bytes from conn
+// along with the Unix file descriptors attached to it.
+func readMessageWithFDs(t *testing.T, conn *net.UnixConn, msgLen int) ([]byte, []int) {
+	t.Helper()
+
+	msg := make([]byte, msgLen)
+	oob := make([]byte, syscall.CmsgSpace(4*4))
+	n, oobn, _, _, err := conn.ReadMsgUnix(msg, oob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = io.ReadFull(conn, msg[n:]); err != nil {
+		t.Fatal(err)
+	}
+
+	cmsgs, err := syscall.ParseSocketControlMessage(oob[:oobn])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fds []int
+	for i := range cmsgs {
+		rights, err := syscall.ParseUnixRights(&cmsgs[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		fds = append(fds, rights...)
+	}
+
+	return msg, fds
+}
+
+func TestWriteMessageWithFDs(t *testing.T) {
+	w, r := unixConnPair(t)
+
+	pr, pw, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.Close()
+	defer pw.Close()
+
+	want := []byte("fizz")
+	if err = writeMessageWithFDs(w, want, []int{int(pr.Fd())}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, fds := readMessageWithFDs(t, r, len(want))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+	if len(fds) != 1 {
+		t.Fatalf("expected 1 fd got %d", len(fds))
+	}
+
+	// The received fd must refer to the same pipe.
+	received := os.NewFile(uintptr(fds[0]), "")
+	defer received.Close()
+	if _, err = pw.Write([]byte("buzz")); err != nil {
+		t.Fatal(err)
+	}
+	b := make([]byte, 4)
+	if _, err = io.ReadFull(received, b); err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "buzz" {
+		t.Errorf("expected %q got %q", "buzz", b)
+	}
+}
+
+func TestEncodeGetUnitByPIDFD(t *testing.T) {
+	w, r := unixConnPair(t)
+
+	msgEnc := newMessageEncoder()
+	err := msgEnc.EncodeGetUnitByPIDFD(w, int(os.Stdin.Fd()), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, fds := readMessageWithFDs(t, r, len(getUnitByPIDFDRequest))
+	if diff := cmp.Diff(getUnitByPIDFDRequest, got); diff != "" {
+		t.Error(diff)
+	}
+	if len(fds) != 1 {
+		t.Fatalf("expected 1 fd got %d", len(fds))
+	}
+	syscall.Close(fds[0])
+}
+
+func TestEncodeUnixFDsNoUnixConn(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	err := msgEnc.EncodeGetUnitByPIDFD(&bytes.Buffer{}, int(os.Stdin.Fd()), 3)
+	errMsg := "connection doesn't support unix fd passing"
+	if err == nil || errMsg != err.Error() {
+		t.Fatalf("expected error %q got %q", errMsg, err)
+	}
+
+	// The file descriptors must not leak into the next message.
+	conn := &bytes.Buffer{}
+	if err = msgEnc.EncodeHello(conn, 1); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(helloRequest, conn.Bytes()); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEncodeUnixFDsHeaderError(t *testing.T) {
+	msgEnc := newMessageEncoder()
+	// The pidfd is attached as EncodeGetUnitByPIDFD does,
+	// but the header can't be encoded.
+	msgEnc.fds = append(msgEnc.fds, int(os.Stdin.Fd()))
+	h := header{
+		ByteOrder: littleEndian,
+		Type:      msgTypeMethodCall,
+		Proto:     1,
+		Serial:    3,
+		Fields: []headerField{
+			{Signature: "as", Code: fieldMember},
+		},
+	}
+	err := msgEnc.encode(&bytes.Buffer{}, &h, nil)
+	errMsg := "message header: container type is not supported: as"
+	if err == nil || errMsg != err.Error() {
+		t.Fatalf("expected error %q got %q", errMsg, err)
+	}
+	if len(msgEnc.fds) != 0 {
+		t.Errorf("expected no fds got %v", msgEnc.fds)
+	}
+
+	// The file descriptors must not leak into the next message.
+	conn := &bytes.Buffer{}
+	if err = msgEnc.EncodeHello(conn, 1); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(helloRequest, conn.Bytes()); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDecodeUnixFD(t *testing.T) {
+	w, r := unixConnPair(t)
+
+	pr, pw, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.Close()
+	defer pw.Close()
+
+	if err = writeMessageWithFDs(w, unixFDResponse, []int{int(pr.Fd())}); err != nil {
+		t.Fatal(err)
+	}
+
+	fdReader := newUnixFDReader(r)
+	msgDec := newMessageDecoder()
+	msgDec.UnixFDs = &fdReader.FDs
+
+	fd, err := msgDec.DecodeUnixFD(bufio.NewReader(fdReader))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The received fd must refer to the same pipe.
+	received := os.NewFile(uintptr(fd), "")
+	defer received.Close()
+	if _, err = pw.Write([]byte("buzz")); err != nil {
+		t.Fatal(err)
+	}
+	b := make([]byte, 4)
+	if _, err = io.ReadFull(received, b); err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "buzz" {
+		t.Errorf("expected %q got %q", "buzz", b)
+	}
+
+	// The claimed fd must not be closed by the reader.
+	fdReader.Reset(r)
+	if _, err = received.Stat(); err != nil {
+		t.Errorf("claimed fd was closed: %v", err)
+	}
+}
+
+func TestDecodeUnixFDNotFound(t *testing.T) {
+	conn := bytes.NewReader(unixFDResponse)
+	msgDec := newMessageDecoder()
+
+	_, err := msgDec.DecodeUnixFD(conn)
+	errMsg := "decode unix fd: unix fd not found at index 0"
+	if err == nil || errMsg != err.Error() {
+		t.Fatalf("expected error %q got %q", errMsg, err)
+	}
+}
+
+// unixFDResponse is a reply with a single Unix file descriptor
+// which is sent out-of-band.
+var unixFDResponse = []byte{108, 2, 1, 1, 4, 0, 0, 0, 216, 8, 0, 0, 56, 0, 0, 0, 5, 1, 117, 0, 3, 0, 0, 0, 6, 1, 115, 0, 6, 0, 0, 0, 58, 49, 46, 51, 56, 56, 0, 0, 8, 1, 103, 0, 1, 104, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 9, 1, 117, 0, 1, 0, 0, 0, 0, 0, 0, 0}
@@ -0,0 +1,520 @@
+package systemd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// systemdSignalsRule is a match rule which subscribes
+// the connection to all the signals emitted by systemd.
+const systemdSignalsRule = "type='signal',sender='org.freedesktop.systemd1'"
+
+// jobRemovedRule is a match rule which subscribes the connection
+// to JobRemoved signals emitted by systemd when the jobs finish.
+const jobRemovedRule = "type='signal',sender='org.freedesktop.systemd1'," +
+	"interface='org.freedesktop.systemd1.Manager',member='JobRemoved'"
+
+// reloadingRule is a match rule which subscribes the connection
+// to Reloading signals emitted by systemd when it reloads its configuration.
+const reloadingRule = "type='signal',sender='org.freedesktop.systemd1'," +
+	"interface='org.freedesktop.systemd1.Manager',member='Reloading'"
+
+// NewWatcher creates a Watcher which receives systemd signals
+// over its own connection to the message bus.
+// The options are the same as in New,
+// except for WithKeepAlive which is ignored,
+// since the pings would compete with the signals for the connection.
+//
+// The Watcher is separate from a Client,
+// because a Client reads only the replies to its method calls,
+// and the signals would pile up unread between the calls,
+// or block the reply the caller waits for.
+func NewWatcher(opts ...Option) (*Watcher, error) {
+	opts = append(opts, WithKeepAlive(0))
+	c, err := New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWatcher(c, systemdSignalsRule)
+}
+
+// NewWatcherWithConn creates a new Watcher similar to NewWatcher,
+// but it uses the already established connection to the message bus,
+// see NewWithConn.
+//
+// The Watcher takes ownership of conn, i.e., conn is closed by Watcher.Close.
+func NewWatcherWithConn(conn net.Conn, opts ...Option) (*Watcher, error) {
+	opts = append(opts, WithKeepAlive(0))
+	c, err := NewWithConn(conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWatcher(c, systemdSignalsRule)
+}
+
+// newWatcherConfig creates a Watcher similar to NewWatcher,
+// but it connects with the given config,
+// and receives only the signals matching the rule.
+func newWatcherConfig(ctx context.Context, conf Config, rule string) (*Watcher, error) {
+	conf.keepAliveInterval = 0
+	c := newClientConfig(conf)
+
+	conn, err := conf.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.connect(ctx, conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return newWatcher(c, rule)
+}
+
+// newWatcher subscribes the connected Client to systemd signals
+// matching the rule.
+// The Client is closed if the subscription fails.
+func newWatcher(c *Client, rule string) (*Watcher, error) {
+	// The signal's member and arguments are found in the header fields.
+	c.msgDec.SkipHeaderFields = false
+
+	if err := c.addMatch(rule); err != nil {
+		c.Close()
+		return nil, err
+	}
+	if err := c.subscribe(); err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	w := Watcher{
+		c:    c,
+		done: make(chan struct{}),
+	}
+	return &w, nil
+}
+
+// Watcher receives systemd signals such as UnitNew, JobRemoved,
+// and org.freedesktop.DBus.Properties.PropertiesChanged.
+// The signals are read either with Next or from the Events channel,
+// but not both.
+type Watcher struct {
+	c *Client
+	// events is the channel returned by Events.
+	events chan Signal
+	// eventsOnce starts reading the signals into events channel once.
+	eventsOnce sync.Once
+	// done is closed when the Watcher is closed.
+	done      chan struct{}
+	closeOnce sync.Once
+	// wg waits for the goroutine which reads the events to exit.
+	wg sync.WaitGroup
+	// err is the error which stopped the Events channel.
+	err error
+}
+
+// Next blocks until the next signal is received.
+// It returns an error when the connection is closed, e.g.,
+// by Watcher.Close from another goroutine.
+func (w *Watcher) Next() (Signal, error) {
+	var s Signal
+	c := w.c
+	if err := c.lock(); err != nil {
+		return s, err
+	}
+	defer c.mu.Unlock()
+
+	// The signals arrive whenever systemd emits them,
+	// so the connection timeout doesn't apply.
+	if err := c.conn.SetReadDeadline(time.Time{}); err != nil {
+		return s, fmt.Errorf("set deadline: %w", err)
+	}
+
+	err := c.msgDec.DecodeSignal(c.bufConn, &s)
+	// The file descriptors aren't expected in signals.
+	if c.fdReader != nil {
+		c.fdReader.CloseFDs()
+	}
+	if err != nil {
+		return s, fmt.Errorf("decode signal: %w", err)
+	}
+
+	return s, nil
+}
+
+// Events returns a channel of signals which are read in a goroutine.
+// The channel is closed when the Watcher is closed
+// or when reading fails, see Err.
+func (w *Watcher) Events() <-chan Signal {
+	w.eventsOnce.Do(func() {
+		w.events = make(chan Signal)
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.readEvents()
+		}()
+	})
+
+	return w.events
+}
+
+// readEvents sends the received signals to the events channel
+// until the Watcher is closed or an error occurs.
+func (w *Watcher) readEvents() {
+	defer close(w.events)
+
+	for {
+		s, err := w.Next()
+		if err != nil {
+			// The error is expected when the Watcher is closed.
+			select {
+			case <-w.done:
+			default:
+				w.err = err
+			}
+			return
+		}
+
+		select {
+		case w.events <- s:
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// Err returns the error which closed the Events channel.
+// It is nil if the channel was closed by Watcher.Close.
+// Err must be called after the channel is closed.
+func (w *Watcher) Err() error {
+	return w.err
+}
+
+// Close closes the connection
+// which unblocks Next and closes the Events channel.
+// Close returns once the goroutine started by Events exited.
+func (w *Watcher) Close() error {
+	w.closeOnce.Do(func() {
+		close(w.done)
+	})
+
+	// The connection is closed first,
+	// because the goroutine is likely blocked reading from it.
+	err := w.c.Close()
+	w.wg.Wait()
+
+	return err
+}
+
+// WatchMainPID calls f with the main PID of the service,
+// and then calls it again whenever the main PID changes,
+// e.g., when the service is restarted or stopped (the PID is zero).
+// It blocks until the context is canceled and returns the context's error,
+// or until receiving the signals fails.
+//
+// The changes are tracked with PropertiesChanged signals of the unit's object
+// received over a separate connection similar to NewWatcher,
+// because the Client's connection is needed to read the initial PID.
+// Note, the Client must not be used by the caller until WatchMainPID returns.
+func (c *Client) WatchMainPID(ctx context.Context, service string, f func(pid uint32)) error {
+	path := unitObjectPath(service)
+	rule := "type='signal',sender='org.freedesktop.systemd1'," +
+		"interface='org.freedesktop.DBus.Properties',member='PropertiesChanged'," +
+		"path='" + path + "',arg0='org.freedesktop.systemd1.Service'"
+	w, err := newWatcherConfig(ctx, c.conf, rule)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	events := w.Events()
+
+	// The PID is read after the subscription,
+	// so a change in between isn't missed.
+	pid, err := c.MainPIDAtPath(path)
+	if err != nil {
+		return err
+	}
+	f(pid)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case s, ok := <-events:
+			if !ok {
+				return w.Err()
+			}
+
+			newPID, isChanged, err := changedMainPID(&s)
+			if err != nil {
+				return err
+			}
+			if !isChanged {
+				continue
+			}
+			// The property was invalidated without sending its value.
+			if newPID == nil {
+				if pid, err = c.MainPIDAtPath(path); err != nil {
+					return err
+				}
+			} else {
+				pid = *newPID
+			}
+			f(pid)
+		}
+	}
+}
+
+// SubscribeToUnit streams the changes of the unit's properties,
+// e.g., ActiveState when the unit is started or stopped.
+// The changes are received as PropertiesChanged signals of the unit's object
+// over a separate connection similar to NewWatcher,
+// so the Client can be used while the changes are streamed.
+//
+// The changes channel is closed when the context is done,
+// or when receiving or decoding the signals fails, see UnitSubscription.Err.
+func (c *Client) SubscribeToUnit(ctx context.Context, name string) (*UnitSubscription, error) {
+	rule := "type='signal',sender='org.freedesktop.systemd1'," +
+		"interface='org.freedesktop.DBus.Properties',member='PropertiesChanged'," +
+		"path='" + unitObjectPath(name) + "'"
+	w, err := newWatcherConfig(ctx, c.conf, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := UnitSubscription{
+		changes: make(chan PropertiesChanged),
+	}
+	go sub.readChanges(ctx, w)
+
+	return &sub, nil
+}
+
+// UnitSubscription streams the changes of the unit's properties,
+// see Client.SubscribeToUnit.
+type UnitSubscription struct {
+	// changes is the channel returned by Changes.
+	changes chan PropertiesChanged
+	// err is the error which stopped the changes channel.
+	err error
+}
+
+// Changes returns a channel of the unit's property changes.
+// The channel is closed when the context passed to SubscribeToUnit is done
+// or when receiving the signals fails, see Err.
+func (s *UnitSubscription) Changes() <-chan PropertiesChanged {
+	return s.changes
+}
+
+// Err returns the error which closed the Changes channel.
+// It is nil if the channel was closed because the context is done.
+// Err must be called after the channel is closed.
+func (s *UnitSubscription) Err() error {
+	return s.err
+}
+
+// readChanges sends the changes received by the watcher
+// to the changes channel until the context is done or an error occurs.
+func (s *UnitSubscription) readChanges(ctx context.Context, w *Watcher) {
+	defer close(s.changes)
+	defer w.Close()
+
+	events := w.Events()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case sig, ok := <-events:
+			if !ok {
+				s.err = w.Err()
+				return
+			}
+
+			p, ok, err := sig.PropertiesChanged()
+			if err != nil {
+				s.err = fmt.Errorf("decode PropertiesChanged: %w", err)
+				return
+			}
+			if !ok {
+				continue
+			}
+
+			select {
+			case s.changes <- p:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// unitObjectPath returns the object path of the unit,
+// e.g., "/org/freedesktop/systemd1/unit/dbus_2eservice" for "dbus.service".
+func unitObjectPath(name string) string {
+	var buf bytes.Buffer
+	buf.WriteString("/org/freedesktop/systemd1/unit/")
+	escapeBusLabel(name, &buf)
+	return buf.String()
+}
+
+// changedMainPID looks up MainPID in PropertiesChanged signal
+// of org.freedesktop.systemd1.Service interface.
+// The signal has the "sa{sv}as" signature, i.e.,
+// the interface name, the changed properties with their values,
+// and the invalidated properties without the values.
+// The returned pid is nil when MainPID was invalidated.
+func changedMainPID(s *Signal) (pid *uint32, isChanged bool, err error) {
+	if s.Member != "PropertiesChanged" || s.Signature != "sa{sv}as" ||
+		s.Args[0].S != "org.freedesktop.systemd1.Service" {
+		return nil, false, nil
+	}
+
+	if s.Args[1].Raw != nil {
+		err = eachRawProperty(s.Args[1].Raw, func(name string, v Variant) bool {
+			if name == "MainPID" && v.Signature == "u" {
+				u := uint32(v.U)
+				pid = &u
+				isChanged = true
+				return false
+			}
+			return true
+		})
+		if err != nil || isChanged {
+			return pid, isChanged, err
+		}
+	}
+
+	for _, name := range s.Args[2].Strings {
+		if name == "MainPID" {
+			return nil, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// StartUnitAndWait enqueues a start job for the unit similar to StartUnit,
+// and blocks until the job finishes or the context is done.
+// It returns the job result, e.g., "done" when the unit came up,
+// or "failed", see StopUnitAndWait.
+func (c *Client) StartUnitAndWait(ctx context.Context, name, mode string) (string, error) {
+	return c.unitJobAndWait(ctx, "StartUnit", name, mode)
+}
+
+// StopUnitAndWait enqueues a stop job for the unit similar to StopUnit,
+// and blocks until the job finishes or the context is done.
+// It returns the job result, e.g., "done", "failed", "canceled",
+// "timeout", "dependency", or "skipped".
+//
+// The job is tracked with JobRemoved signal
+// received over a separate connection similar to WatchMainPID.
+func (c *Client) StopUnitAndWait(ctx context.Context, name, mode string) (string, error) {
+	return c.unitJobAndWait(ctx, "StopUnit", name, mode)
+}
+
+// unitJobAndWait enqueues a job for the unit with one of the systemd methods,
+// see unitJob, and waits for JobRemoved signal of that job.
+// The signals are subscribed to before the job is enqueued,
+// so the job can't finish unnoticed.
+func (c *Client) unitJobAndWait(ctx context.Context, member, name, mode string) (string, error) {
+	w, err := newWatcherConfig(ctx, c.conf, jobRemovedRule)
+	if err != nil {
+		return "", err
+	}
+	defer w.Close()
+	events := w.Events()
+
+	jobPath, err := c.unitJob(member, name, mode, nil)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case s, ok := <-events:
+			if !ok {
+				return "", fmt.Errorf("wait for job %s: %w", jobPath, w.Err())
+			}
+
+			if j, ok := s.JobRemoved(); ok && j.Path == jobPath {
+				return j.Result, nil
+			}
+		}
+	}
+}
+
+// ReloadAndWait reloads systemd configuration similar to Reload,
+// and blocks until Reloading signal confirms that the reload is finished
+// or the context is done,
+// so the following calls don't observe the stale state.
+//
+// The signal is received over a separate connection similar to WatchMainPID.
+// If the context has a deadline, it's also the timeout of Reload call.
+func (c *Client) ReloadAndWait(ctx context.Context) error {
+	w, err := newWatcherConfig(ctx, c.conf, reloadingRule)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	events := w.Events()
+
+	var opts []CallOption
+	if d, ok := ctx.Deadline(); ok {
+		opts = append(opts, WithCallTimeout(time.Until(d)))
+	}
+	if err = c.Reload(opts...); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case s, ok := <-events:
+			if !ok {
+				return fmt.Errorf("wait for reload: %w", w.Err())
+			}
+
+			if active, ok := s.Reloading(); ok && !active {
+				return nil
+			}
+		}
+	}
+}
+
+// addMatch subscribes the connection to the messages matching the rule.
+func (c *Client) addMatch(rule string) error {
+	return c.call("AddMatch", nil,
+		func(conn io.Writer, serial uint32) error {
+			return c.msgEnc.EncodeAddMatch(conn, rule, serial)
+		},
+		func(conn io.Reader) error {
+			err := c.msgDec.DecodeEmptyReply(conn)
+			if err == nil {
+				c.isSubscribed = true
+			}
+			return err
+		},
+	)
+}
+
+// subscribe enables systemd to emit the Manager's signals.
+func (c *Client) subscribe() error {
+	return c.call("Subscribe", nil,
+		func(conn io.Writer, serial uint32) error {
+			return c.msgEnc.EncodeSubscribe(conn, serial)
+		},
+		func(conn io.Reader) error {
+			return c.msgDec.DecodeEmptyReply(conn)
+		},
+	)
+}
@@ -0,0 +1,417 @@
+package systemd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWatcher(t *testing.T) {
+	replies := io.MultiReader(
+		strings.NewReader("OK bde8d2222a9e966420ee8c1a63e972b4\r\n"),
+		bytes.NewReader(helloResponse),
+		// The replies to AddMatch and Subscribe.
+		bytes.NewReader(pingResponse),
+		bytes.NewReader(pingResponse),
+		bytes.NewReader(unitNewSignal),
+		bytes.NewReader(pingResponse),
+		bytes.NewReader(unitNewSignal),
+	)
+	conn := &replayConn{replies: replies}
+
+	w, err := NewWatcherWithConn(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Signal{
+		Sender:    ":1.0",
+		Path:      "/org/freedesktop/systemd1",
+		Interface: "org.freedesktop.systemd1.Manager",
+		Member:    "UnitNew",
+		Signature: "so",
+		Args: []Variant{
+			{Signature: "s", S: "dbus.service"},
+			{Signature: "o", S: "/org/freedesktop/systemd1/unit/dbus_2eservice"},
+		},
+	}
+	s, err := w.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, s); diff != "" {
+		t.Error(diff)
+	}
+
+	// The unrelated reply is skipped.
+	var got []Signal
+	for s := range w.Events() {
+		got = append(got, s)
+	}
+	if diff := cmp.Diff([]Signal{want}, got); diff != "" {
+		t.Error(diff)
+	}
+	// The channel is closed when the replies run out.
+	if w.Err() == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestWatcherClose(t *testing.T) {
+	// The signals never come, so Events blocks until the Watcher is closed.
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	replies := io.MultiReader(
+		strings.NewReader("OK bde8d2222a9e966420ee8c1a63e972b4\r\n"),
+		bytes.NewReader(helloResponse),
+		bytes.NewReader(pingResponse),
+		bytes.NewReader(pingResponse),
+		pr,
+	)
+	conn := &pipeConn{replayConn: replayConn{replies: replies}, pr: pr}
+
+	w, err := NewWatcherWithConn(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := w.Events()
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// The channel must be closed by the time Close returns.
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Error("expected closed channel")
+		}
+	default:
+		t.Error("expected closed channel, the goroutine is still running")
+	}
+	if err = w.Err(); err != nil {
+		t.Errorf("expected no error got %v", err)
+	}
+}
+
+func TestClientWatchMainPID(t *testing.T) {
+	addr := signalBus(t,
+		mainPIDChangedSignal,
+		activeStateChangedSignal,
+		mainPIDInvalidatedSignal,
+	)
+
+	// The Client reads the initial PID,
+	// and then the PID once it was invalidated.
+	replies := io.MultiReader(
+		bytes.NewReader(mainPIDResponse),
+		bytes.NewReader(mainPIDResponse),
+	)
+	conn := &replayConn{replies: replies}
+	c := newClient([]Option{WithAddress(addr)})
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var got []uint32
+	err := c.WatchMainPID(ctx, "dbus.service", func(pid uint32) {
+		got = append(got, pid)
+		if len(got) == 3 {
+			cancel()
+		}
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled got %v", err)
+	}
+
+	want := []uint32{2375, 2400, 2375}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestClientSubscribeToUnit(t *testing.T) {
+	addr := signalBus(t,
+		activeStateChangedSignal,
+		mainPIDInvalidatedSignal,
+	)
+	c := newClient([]Option{WithAddress(addr)})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sub, err := c.SubscribeToUnit(ctx, "dbus.service")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for p := range sub.Changes() {
+		for name, v := range p.Changed {
+			got = append(got, name+"="+v.S)
+		}
+		got = append(got, p.Invalidated...)
+		if len(got) == 3 {
+			cancel()
+		}
+	}
+
+	want := []string{"ActiveState=activating", "ExecMainStartTimestamp", "MainPID"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error(diff)
+	}
+	if err = sub.Err(); err != nil {
+		t.Errorf("expected no error after cancel got %v", err)
+	}
+}
+
+func TestClientSubscribeToUnitError(t *testing.T) {
+	// The length of the ActiveState value exceeds the changed properties.
+	garbled := append([]byte(nil), activeStateChangedSignal...)
+	i := bytes.Index(garbled, []byte("activating")) - 4
+	garbled[i] = 200
+
+	addr := signalBus(t, garbled, mainPIDInvalidatedSignal)
+	c := newClient([]Option{WithAddress(addr)})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sub, err := c.SubscribeToUnit(ctx, "dbus.service")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for p := range sub.Changes() {
+		t.Errorf("unexpected change %+v", p)
+	}
+
+	err = sub.Err()
+	if err == nil || !strings.HasPrefix(err.Error(), "decode PropertiesChanged: ") {
+		t.Fatalf("expected decode error got %v", err)
+	}
+}
+
+func TestClientStopUnitAndWait(t *testing.T) {
+	addr := signalBus(t, otherJobRemovedSignal, jobRemovedSignal)
+
+	// The reply contains the job path /org/freedesktop/systemd1/job/1292.
+	conn := &replayConn{replies: bytes.NewReader(startUnitResponse)}
+	c := newClient([]Option{WithAddress(addr)})
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	got, err := c.StopUnitAndWait(ctx, "dbus.service", "replace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "done"; want != got {
+		t.Errorf("expected %q got %q", want, got)
+	}
+}
+
+func TestClientStartUnitAndWait(t *testing.T) {
+	// The signal of the other job is ignored.
+	addr := signalBus(t, otherJobRemovedSignal, jobRemovedSignal)
+
+	conn := &replayConn{replies: bytes.NewReader(startUnitResponse)}
+	c := newClient([]Option{WithAddress(addr)})
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	got, err := c.StartUnitAndWait(ctx, "dbus.service", "replace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "done"; want != got {
+		t.Errorf("expected %q got %q", want, got)
+	}
+}
+
+func TestClientStartUnitAndWaitError(t *testing.T) {
+	// The bus isn't expected to send signals,
+	// since the job wasn't enqueued.
+	addr := signalBus(t)
+
+	conn := &replayConn{replies: bytes.NewReader(startUnitNoSuchUnitResponse)}
+	c := newClient([]Option{WithAddress(addr)})
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := c.StartUnitAndWait(ctx, "nope.service", "replace")
+	if !errors.Is(err, ErrNoSuchUnit) {
+		t.Fatalf("expected ErrNoSuchUnit got %v", err)
+	}
+}
+
+func TestClientStopUnitAndWaitCanceled(t *testing.T) {
+	// The job never finishes.
+	addr := signalBus(t, otherJobRemovedSignal)
+
+	conn := &replayConn{replies: bytes.NewReader(startUnitResponse)}
+	c := newClient([]Option{WithAddress(addr)})
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.StopUnitAndWait(ctx, "dbus.service", "replace")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded got %v", err)
+	}
+}
+
+// signalBus starts a message bus which accepts a watcher connection,
+// replies to its Hello, AddMatch, and Subscribe calls,
+// and then sends the signals.
+// It returns the bus address.
+func TestClientReloadAndWait(t *testing.T) {
+	addr := signalBus(t, reloadingSignal, reloadedSignal)
+
+	// The empty reply to Reload.
+	conn := &replayConn{replies: bytes.NewReader(pingResponse)}
+	c := newClient([]Option{WithAddress(addr)})
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := c.ReloadAndWait(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientReloadAndWaitCanceled(t *testing.T) {
+	// The reload never finishes.
+	addr := signalBus(t, reloadingSignal)
+
+	conn := &replayConn{replies: bytes.NewReader(pingResponse)}
+	c := newClient([]Option{WithAddress(addr)})
+	c.conn = conn
+	c.bufConn.Reset(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := c.ReloadAndWait(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded got %v", err)
+	}
+}
+
+func signalBus(t *testing.T, signals ...[]byte) string {
+	path := filepath.Join(t.TempDir(), "bus")
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		conn.Write([]byte("OK bde8d2222a9e966420ee8c1a63e972b4\r\n"))
+		conn.Write(helloResponse)
+		// The replies to AddMatch and Subscribe.
+		conn.Write(pingResponse)
+		conn.Write(pingResponse)
+		for _, s := range signals {
+			conn.Write(s)
+		}
+		io.Copy(io.Discard, conn)
+	}()
+
+	return "unix:path=" + path
+}
+
+func TestChangedMainPID(t *testing.T) {
+	pid := func(u uint32) *uint32 { return &u }
+	tt := map[string]struct {
+		in          []byte
+		wantPID     *uint32
+		wantChanged bool
+	}{
+		"changed": {
+			in:          mainPIDChangedSignal,
+			wantPID:     pid(2400),
+			wantChanged: true,
+		},
+		"other interface": {
+			in: activeStateChangedSignal,
+		},
+		"invalidated": {
+			in:          mainPIDInvalidatedSignal,
+			wantChanged: true,
+		},
+		"other signal": {
+			in: unitNewSignal,
+		},
+	}
+
+	msgDec := newMessageDecoder()
+	msgDec.SkipHeaderFields = false
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			var s Signal
+			if err := msgDec.DecodeSignal(bytes.NewReader(tc.in), &s); err != nil {
+				t.Fatal(err)
+			}
+
+			gotPID, gotChanged, err := changedMainPID(&s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gotChanged != tc.wantChanged {
+				t.Errorf("expected changed %t got %t", tc.wantChanged, gotChanged)
+			}
+			if diff := cmp.Diff(tc.wantPID, gotPID); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+// pipeConn is a replayConn which unblocks the reads when closed.
+type pipeConn struct {
+	replayConn
+	pr *io.PipeReader
+}
+
+func (c *pipeConn) Close() error { return c.pr.Close() }
+
+// mainPIDChangedSignal is PropertiesChanged signal of dbus.service
+// whose MainPID changed to 2400 along with other Service properties.
+var mainPIDChangedSignal = []byte{108, 4, 1, 1, 132, 0, 0, 0, 252, 8, 0, 0, 157, 0, 0, 0, 1, 1, 111, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 2, 1, 115, 0, 31, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 0, 3, 1, 115, 0, 17, 0, 0, 0, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 67, 104, 97, 110, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 8, 115, 97, 123, 115, 118, 125, 97, 115, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 83, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 80, 0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 83, 116, 97, 116, 117, 115, 84, 101, 120, 116, 0, 1, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 77, 97, 105, 110, 80, 73, 68, 0, 1, 117, 0, 0, 96, 9, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 67, 111, 110, 116, 114, 111, 108, 80, 73, 68, 0, 1, 117, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+
+// activeStateChangedSignal is PropertiesChanged signal of dbus.service
+// whose ActiveState of org.freedesktop.systemd1.Unit interface changed.
+var activeStateChangedSignal = []byte{108, 4, 1, 1, 80, 0, 0, 0, 253, 8, 0, 0, 157, 0, 0, 0, 1, 1, 111, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 2, 1, 115, 0, 31, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 0, 3, 1, 115, 0, 17, 0, 0, 0, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 67, 104, 97, 110, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 8, 115, 97, 123, 115, 118, 125, 97, 115, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 29, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 85, 110, 105, 116, 0, 0, 0, 35, 0, 0, 0, 11, 0, 0, 0, 65, 99, 116, 105, 118, 101, 83, 116, 97, 116, 101, 0, 1, 115, 0, 0, 10, 0, 0, 0, 97, 99, 116, 105, 118, 97, 116, 105, 110, 103, 0, 0, 0, 0, 0, 0}
+
+// mainPIDInvalidatedSignal is PropertiesChanged signal of dbus.service
+// whose MainPID was invalidated, i.e., sent without the value.
+var mainPIDInvalidatedSignal = []byte{108, 4, 1, 1, 92, 0, 0, 0, 254, 8, 0, 0, 157, 0, 0, 0, 1, 1, 111, 0, 45, 0, 0, 0, 47, 111, 114, 103, 47, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 47, 115, 121, 115, 116, 101, 109, 100, 49, 47, 117, 110, 105, 116, 47, 100, 98, 117, 115, 95, 50, 101, 115, 101, 114, 118, 105, 99, 101, 0, 0, 0, 2, 1, 115, 0, 31, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 68, 66, 117, 115, 46, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 0, 3, 1, 115, 0, 17, 0, 0, 0, 80, 114, 111, 112, 101, 114, 116, 105, 101, 115, 67, 104, 97, 110, 103, 101, 100, 0, 0, 0, 0, 0, 0, 0, 8, 1, 103, 0, 8, 115, 97, 123, 115, 118, 125, 97, 115, 0, 0, 0, 7, 1, 115, 0, 4, 0, 0, 0, 58, 49, 46, 48, 0, 0, 0, 0, 32, 0, 0, 0, 111, 114, 103, 46, 102, 114, 101, 101, 100, 101, 115, 107, 116, 111, 112, 46, 115, 121, 115, 116, 101, 109, 100, 49, 46, 83, 101, 114, 118, 105, 99, 101, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40, 0, 0, 0, 22, 0, 0, 0, 69, 120, 101, 99, 77, 97, 105, 110, 83, 116, 97, 114, 116, 84, 105, 109, 101, 115, 116, 97, 109, 112, 0, 0, 7, 0, 0, 0, 77, 97, 105, 110, 80, 73, 68, 0}